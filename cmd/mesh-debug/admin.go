@@ -0,0 +1,394 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go-mesh/internal/app"
+	"go-mesh/internal/meshtastic"
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// Admin options
+	adminDest          string
+	adminTimeout       time.Duration
+	adminRebootSeconds int32
+	adminFullReset     bool
+	adminConfirm       bool
+	adminRemovePos     bool
+)
+
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Read and change device settings with AdminMessages",
+	Long: `Read and change the settings of the connected device, or of a remote node
+with --dest, using AdminMessages. Requires a serial or --tcp connection.
+
+Settings are named section.field as shown by "admin get", e.g. lora.hop_limit,
+mqtt.enabled, owner.long_name or channel.1.settings.name. Remote nodes running
+firmware 2.5 or later must have our node's public key as an admin key; older
+firmware is reached on a channel named "admin" if the local device has one.`,
+}
+
+var adminGetCmd = &cobra.Command{
+	Use:   "get <section>...",
+	Short: "Print settings: a config or module section, owner, channels or metadata",
+	Example: `  mesh-debug --port COM3 admin get lora mqtt owner
+  mesh-debug --tcp --host 192.168.1.100 admin get channels --dest !a1b2c3d4`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runAdminGet,
+}
+
+var adminSetCmd = &cobra.Command{
+	Use:   "set <section.field> <value> [<section.field> <value>]...",
+	Short: "Change settings",
+	Long: `Change one or more settings. Enums are given by name, bytes as base64 and
+repeated fields as comma separated lists. Changes to several sections are
+applied in one edit transaction, so the device only saves and reboots once.`,
+	Example: `  mesh-debug --port COM3 admin set lora.hop_limit 5
+  mesh-debug --port COM3 admin set owner.long_name "Hilltop Relay" device.role ROUTER`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 || len(args)%2 != 0 {
+			return fmt.Errorf("expected pairs of setting and value")
+		}
+		return nil
+	},
+	RunE: runAdminSet,
+}
+
+var adminRebootCmd = &cobra.Command{
+	Use:   "reboot",
+	Short: "Reboot the node",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withAdmin(func(admin *meshtastic.Admin) error {
+			if err := admin.Reboot(adminRebootSeconds); err != nil {
+				return err
+			}
+			fmt.Printf("!%08x will reboot in %d seconds\n", admin.Node(), adminRebootSeconds)
+			return nil
+		})
+	},
+}
+
+var adminFactoryResetCmd = &cobra.Command{
+	Use:   "factory-reset",
+	Short: "Return the node's settings to factory defaults",
+	Long: `Return the node's config to factory defaults. With --full the NodeDB and
+Bluetooth bonds are cleared too. Requires --yes.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !adminConfirm {
+			return fmt.Errorf("factory reset erases the node's settings, pass --yes to confirm")
+		}
+		return withAdmin(func(admin *meshtastic.Admin) error {
+			if err := admin.FactoryReset(adminFullReset); err != nil {
+				return err
+			}
+			fmt.Printf("!%08x is resetting to factory defaults\n", admin.Node())
+			return nil
+		})
+	},
+}
+
+var adminPositionCmd = &cobra.Command{
+	Use:   "position <latitude> <longitude> [altitude]",
+	Short: "Set or, with --remove, clear the node's fixed position",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if adminRemovePos {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.RangeArgs(2, 3)(cmd, args)
+	},
+	RunE: runAdminPosition,
+}
+
+func init() {
+	adminCmd.PersistentFlags().StringVar(&adminDest, "dest", "", "Remote node to administer (!hex or 0xhex), default the connected device")
+	adminCmd.PersistentFlags().DurationVar(&adminTimeout, "timeout", meshtastic.DefaultAdminTimeout, "How long to wait for each reply")
+	adminRebootCmd.Flags().Int32Var(&adminRebootSeconds, "seconds", 5, "Delay before rebooting")
+	adminFactoryResetCmd.Flags().BoolVar(&adminFullReset, "full", false, "Also clear the NodeDB and Bluetooth bonds")
+	adminFactoryResetCmd.Flags().BoolVar(&adminConfirm, "yes", false, "Confirm the reset")
+	adminPositionCmd.Flags().BoolVar(&adminRemovePos, "remove", false, "Clear the fixed position")
+
+	adminCmd.AddCommand(adminGetCmd, adminSetCmd, adminRebootCmd, adminFactoryResetCmd, adminPositionCmd)
+	rootCmd.AddCommand(adminCmd)
+}
+
+// withAdmin connects, waits for the config dump and runs fn against the target node
+func withAdmin(fn func(admin *meshtastic.Admin) error) error {
+	var dest uint32
+	if adminDest != "" {
+		var err error
+		if dest, err = meshtastic.ParseNodeID(adminDest); err != nil {
+			return err
+		}
+	}
+
+	return withDevice(func(client *meshtastic.Client) error {
+		admin := client.Admin(dest)
+		admin.Timeout = adminTimeout
+		return fn(admin)
+	})
+}
+
+// withDevice connects, waits for the config dump and runs fn with the client
+func withDevice(fn func(client *meshtastic.Client) error) error {
+	return connectDevice(startAndWaitForConfig, fn)
+}
+
+// withFullConfig is withDevice, but fails if the config dump doesn't finish
+func withFullConfig(fn func(client *meshtastic.Client) error) error {
+	return connectDevice(startAndRequireConfig, fn)
+}
+
+// connectDevice connects, starts the client with start and runs fn with it
+func connectDevice(start func(client *meshtastic.Client) error, fn func(client *meshtastic.Client) error) error {
+	config, err := buildConfig()
+	if err != nil {
+		return err
+	}
+
+	debugger := app.NewDebugger(config)
+	client, err := debugger.InitClient()
+	if err != nil {
+		return err
+	}
+	defer debugger.Close()
+
+	if err := start(client); err != nil {
+		return err
+	}
+	return fn(client)
+}
+
+func runAdminGet(cmd *cobra.Command, args []string) error {
+	return withAdmin(func(admin *meshtastic.Admin) error {
+		for _, section := range args {
+			if err := printAdminSection(admin, section); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// printAdminSection requests a section from the node and prints its settings
+func printAdminSection(admin *meshtastic.Admin, section string) error {
+	switch section {
+	case "owner":
+		owner, err := admin.GetOwner()
+		if err != nil {
+			return err
+		}
+		printSettings("owner", owner)
+
+	case "metadata":
+		metadata, err := admin.GetMetadata()
+		if err != nil {
+			return err
+		}
+		printSettings("metadata", metadata)
+
+	case "channels":
+		for index := uint32(0); index < meshtastic.MaxChannels; index++ {
+			channel, err := admin.GetChannel(index)
+			if err != nil {
+				return err
+			}
+			if channel.GetRole() != pb.Channel_DISABLED {
+				printSettings(fmt.Sprintf("channel.%d", index), channel)
+			}
+		}
+
+	default:
+		var config proto.Message
+		var err error
+		if configType, ok := meshtastic.ConfigTypeFor(section); ok {
+			config, err = admin.GetConfig(configType)
+		} else if moduleType, ok := meshtastic.ModuleConfigTypeFor(section); ok {
+			config, err = admin.GetModuleConfig(moduleType)
+		} else {
+			return fmt.Errorf("unknown section %q", section)
+		}
+		if err != nil {
+			return err
+		}
+		name, msg := meshtastic.SectionOf(config)
+		printSettings(name, msg)
+	}
+	return nil
+}
+
+// printSettings prints each setting of a message as prefix.field = value
+func printSettings(prefix string, msg proto.Message) {
+	for _, field := range meshtastic.ConfigFields(prefix, msg) {
+		fmt.Printf("%s.%s = %s\n", prefix, field.Name, field.Value)
+	}
+}
+
+// adminTarget is a section being changed: the message read from the node and
+// the function that writes it back
+type adminTarget struct {
+	msg   proto.Message
+	apply func() error
+}
+
+func runAdminSet(cmd *cobra.Command, args []string) error {
+	return withAdmin(func(admin *meshtastic.Admin) error {
+		targets := make(map[string]*adminTarget)
+		for i := 0; i < len(args); i += 2 {
+			key, path, err := adminSettingPath(args[i])
+			if err != nil {
+				return err
+			}
+			target, exists := targets[key]
+			if !exists {
+				if target, err = loadAdminTarget(admin, key); err != nil {
+					return err
+				}
+				targets[key] = target
+			}
+			if err := meshtastic.SetConfigValue(target.msg, path, args[i+1]); err != nil {
+				return err
+			}
+		}
+
+		keys := make([]string, 0, len(targets))
+		for key := range targets {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		// Several sections go in one transaction, so the node saves once
+		transaction := len(targets) > 1
+		if transaction {
+			if err := admin.BeginEditSettings(); err != nil {
+				return err
+			}
+		}
+		for _, key := range keys {
+			if err := targets[key].apply(); err != nil {
+				return fmt.Errorf("failed to set %s: %w", key, err)
+			}
+		}
+		if transaction {
+			if err := admin.CommitEditSettings(); err != nil {
+				return err
+			}
+		}
+
+		for i := 0; i < len(args); i += 2 {
+			fmt.Printf("Set %s = %s on !%08x\n", args[i], args[i+1], admin.Node())
+		}
+		return nil
+	})
+}
+
+// adminSettingPath splits a setting into the section it belongs to and the
+// field path within the message that section is read as
+func adminSettingPath(setting string) (string, string, error) {
+	parts := strings.SplitN(setting, ".", 3)
+	if len(parts) < 2 {
+		return "", "", fmt.Errorf("setting %q should be section.field", setting)
+	}
+
+	switch parts[0] {
+	case "owner":
+		return "owner", strings.Join(parts[1:], "."), nil
+	case "channel":
+		if len(parts) < 3 {
+			return "", "", fmt.Errorf("setting %q should be channel.<index>.field", setting)
+		}
+		if index, err := strconv.Atoi(parts[1]); err != nil || index < 0 || index >= meshtastic.MaxChannels {
+			return "", "", fmt.Errorf("invalid channel index %q", parts[1])
+		}
+		return "channel." + parts[1], parts[2], nil
+	default:
+		// Config and ModuleConfig paths include the section's oneof field
+		return parts[0], setting, nil
+	}
+}
+
+// loadAdminTarget reads a section from the node so fields can be changed in it
+func loadAdminTarget(admin *meshtastic.Admin, key string) (*adminTarget, error) {
+	if key == "owner" {
+		owner, err := admin.GetOwner()
+		if err != nil {
+			return nil, err
+		}
+		return &adminTarget{msg: owner, apply: func() error { return admin.SetOwner(owner) }}, nil
+	}
+
+	if index, found := strings.CutPrefix(key, "channel."); found {
+		n, _ := strconv.Atoi(index)
+		channel, err := admin.GetChannel(uint32(n))
+		if err != nil {
+			return nil, err
+		}
+		return &adminTarget{msg: channel, apply: func() error { return admin.SetChannel(channel) }}, nil
+	}
+
+	if configType, ok := meshtastic.ConfigTypeFor(key); ok {
+		config, err := admin.GetConfig(configType)
+		if err != nil {
+			return nil, err
+		}
+		return &adminTarget{msg: config, apply: func() error { return admin.SetConfig(config) }}, nil
+	}
+	if moduleType, ok := meshtastic.ModuleConfigTypeFor(key); ok {
+		config, err := admin.GetModuleConfig(moduleType)
+		if err != nil {
+			return nil, err
+		}
+		return &adminTarget{msg: config, apply: func() error { return admin.SetModuleConfig(config) }}, nil
+	}
+	return nil, fmt.Errorf("unknown section %q", key)
+}
+
+func runAdminPosition(cmd *cobra.Command, args []string) error {
+	var position *pb.Position
+	if !adminRemovePos {
+		lat, err := strconv.ParseFloat(args[0], 64)
+		if err != nil || math.Abs(lat) > 90 {
+			return fmt.Errorf("invalid latitude %q", args[0])
+		}
+		lon, err := strconv.ParseFloat(args[1], 64)
+		if err != nil || math.Abs(lon) > 180 {
+			return fmt.Errorf("invalid longitude %q", args[1])
+		}
+		position = &pb.Position{
+			LatitudeI:  proto.Int32(int32(math.Round(lat * 1e7))),
+			LongitudeI: proto.Int32(int32(math.Round(lon * 1e7))),
+		}
+		if len(args) == 3 {
+			alt, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid altitude %q", args[2])
+			}
+			position.Altitude = proto.Int32(int32(alt))
+		}
+	}
+
+	return withAdmin(func(admin *meshtastic.Admin) error {
+		if position == nil {
+			if err := admin.RemoveFixedPosition(); err != nil {
+				return err
+			}
+			fmt.Printf("Removed fixed position of !%08x\n", admin.Node())
+			return nil
+		}
+		if err := admin.SetFixedPosition(position); err != nil {
+			return err
+		}
+		fmt.Printf("Set fixed position of !%08x to %s, %s\n", admin.Node(), args[0], args[1])
+		return nil
+	})
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go-mesh/internal/meshtastic"
+)
+
+var (
+	// Config backup options
+	configIncludeKeys bool
+	configDryRun      bool
+	configTimeout     time.Duration
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Back up device settings to YAML and restore them",
+	Long: `Export the connected device's config, module config, channels and owner names
+to a YAML file, and import such a file into a device, e.g. to clone the settings
+of one radio onto others. Requires a serial or --tcp connection.`,
+}
+
+var configExportCmd = &cobra.Command{
+	Use:   "export <file>",
+	Short: "Write the device's settings to a YAML file",
+	Long: `Write every setting from the device's config dump to a YAML file. The
+device's key pair is left out unless --include-keys is given, as importing it
+into another device would give both nodes the same identity. The file holds
+channel keys, keep it private.`,
+	Example: `  mesh-debug --port COM3 config export field-radio.yaml`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    runConfigExport,
+}
+
+var configImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Apply a YAML settings file to the device",
+	Long: `Compare a YAML settings file with the device's current settings, list the
+differences and write the changed sections in one edit transaction, so the
+device saves and reboots once. Settings missing from the file are left as they
+are, so the file may be a full export or just the settings to change.`,
+	Example: `  mesh-debug --port COM3 config import field-radio.yaml --dry-run
+  mesh-debug --port COM4 config import field-radio.yaml`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigImport,
+}
+
+func init() {
+	configExportCmd.Flags().BoolVar(&configIncludeKeys, "include-keys", false, "Include the device's public and private key")
+	configImportCmd.Flags().BoolVar(&configDryRun, "dry-run", false, "Only list the settings that would change")
+	configImportCmd.Flags().DurationVar(&configTimeout, "timeout", meshtastic.DefaultAdminTimeout, "How long to wait for each reply")
+
+	configCmd.AddCommand(configExportCmd, configImportCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+func runConfigExport(cmd *cobra.Command, args []string) error {
+	return withFullConfig(func(client *meshtastic.Client) error {
+		backup := client.ConfigBackup()
+		if len(backup.Sections) == 0 {
+			return fmt.Errorf("no config received from the device")
+		}
+
+		data, err := meshtastic.MarshalBackup(backup, configIncludeKeys)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(args[0], data, 0600); err != nil {
+			return err
+		}
+		fmt.Printf("Wrote %d config sections and %d channels of !%08x to %s\n",
+			len(backup.Sections), len(backup.Channels), client.GetMyNodeNum(), args[0])
+		return nil
+	})
+}
+
+func runConfigImport(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	return withFullConfig(func(client *meshtastic.Client) error {
+		live := client.ConfigBackup()
+		if len(live.Sections) == 0 {
+			return fmt.Errorf("no config received from the device")
+		}
+		target, err := meshtastic.UnmarshalBackup(data, live)
+		if err != nil {
+			return fmt.Errorf("%s: %w", args[0], err)
+		}
+
+		changes := meshtastic.DiffBackups(live, target)
+		if len(changes) == 0 {
+			fmt.Printf("!%08x already matches %s\n", client.GetMyNodeNum(), args[0])
+			return nil
+		}
+		fmt.Printf("%d settings differ from %s:\n", len(changes), args[0])
+		for _, change := range changes {
+			fmt.Printf("  %s\n", change)
+		}
+		if configDryRun {
+			return nil
+		}
+
+		admin := client.Admin(0)
+		admin.Timeout = configTimeout
+		if err := admin.ApplyBackup(target, changes); err != nil {
+			return err
+		}
+		fmt.Printf("Applied %d settings to !%08x\n", len(changes), client.GetMyNodeNum())
+		return nil
+	})
+}
@@ -1,104 +1,186 @@
-package main
-
-import (
-	"fmt"
-	"os"
-
-	"github.com/spf13/cobra"
-	"go-mesh/internal/app"
-)
-
-var (
-	// Serial connection options
-	port    string
-	baud    int
-	
-	// Network connection options
-	host    string
-	tcpPort int
-	useTCP  bool
-	
-	// Common options
-	verbose bool
-	filter  string
-)
-
-var rootCmd = &cobra.Command{
-	Use:   "mesh-debug",
-	Short: "Meshtastic Terminal Debugger",
-	Long: `A terminal application for connecting to Meshtastic nodes and debugging radio packets.
-
-This tool provides real-time packet capture, analysis, and debugging capabilities
-for Meshtastic mesh networks. Supports multiple connection types:
-
-  Serial: Direct USB/serial connection to device
-  WiFi:   HTTP/WebSocket API connection (limited packet visibility)
-  TCP:    Protocol buffer stream connection (full RF traffic capture)
-
-For maximum RF traffic visibility, use --tcp flag with --host.`,
-	RunE: runDebugger,
-}
-
-func init() {
-	// Serial connection flags
-	rootCmd.Flags().StringVarP(&port, "port", "p", "", "Serial port of Meshtastic device (e.g., COM3)")
-	rootCmd.Flags().IntVarP(&baud, "baud", "b", 115200, "Baud rate for serial connection")
-	
-	// Network connection flags
-	rootCmd.Flags().StringVar(&host, "host", "", "IP address or hostname of Meshtastic device (e.g., 192.168.1.100)")
-	rootCmd.Flags().IntVar(&tcpPort, "tcp-port", 4403, "Port for network connection (80 for HTTP/WiFi, 4403 for TCP protocol buffer stream)")
-	rootCmd.Flags().BoolVar(&useTCP, "tcp", false, "Use TCP protocol buffer stream for full RF traffic (like Python CLI --listen). Requires --host.")
-	
-	// Common flags
-	rootCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose logging")
-	rootCmd.Flags().StringVarP(&filter, "filter", "f", "", "Filter packets (node ID, message type, etc.)")
-	
-	// Make port and host mutually exclusive but one is required
-	rootCmd.MarkFlagsRequiredTogether()
-}
-
-func runDebugger(cmd *cobra.Command, args []string) error {
-	// Validate that either port or host is specified (but not both)
-	if port == "" && host == "" {
-		return fmt.Errorf("either --port (for serial) or --host (for network) must be specified")
-	}
-	if port != "" && host != "" {
-		return fmt.Errorf("cannot specify both --port and --host, choose either serial or network connection")
-	}
-	
-	// Validate TCP flag usage
-	if useTCP && host == "" {
-		return fmt.Errorf("--tcp flag requires --host to be specified")
-	}
-	
-	// Set default port based on connection type
-	if host != "" && tcpPort == 4403 && !useTCP {
-		// If host is specified but not using TCP, default to HTTP port
-		tcpPort = 80
-	}
-	
-	config := &app.Config{
-		// Serial connection
-		Port:    port,
-		Baud:    baud,
-		// Network connection
-		Host:    host,
-		TCPPort: tcpPort,
-		UseTCP:  useTCP,
-		// Common
-		Verbose: verbose,
-		Filter:  filter,
-	}
-	
-	// Connection info is logged to mesh-debug.log instead of stdout to avoid TUI corruption
-	
-	debugger := app.NewDebugger(config)
-	return debugger.Run()
-}
-
-func main() {
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
-	}
-}
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go-mesh/internal/app"
+	"go-mesh/internal/meshtastic"
+	"go-mesh/internal/tcp"
+)
+
+var (
+	// Serial connection options
+	port    string
+	baud    int
+	
+	// Network connection options
+	host    string
+	tcpPort int
+	useTCP  bool
+	heartbeat    time.Duration
+	stallTimeout time.Duration
+	
+	// Common options
+	verbose bool
+	filter  string
+	nodeDB  string
+	keyring string
+	privKey string
+
+	// Recording options
+	recordPath    string
+	recordMaxSize int64
+	recordRotate  time.Duration
+	pcapPath      string
+
+	// Replay options
+	replayPath   string
+	replaySpeed  float64
+	replayPaused bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "mesh-debug",
+	Short: "Meshtastic Terminal Debugger",
+	Long: `A terminal application for connecting to Meshtastic nodes and debugging radio packets.
+
+This tool provides real-time packet capture, analysis, and debugging capabilities
+for Meshtastic mesh networks. Supports multiple connection types:
+
+  Serial: Direct USB/serial connection to device
+  WiFi:   HTTP/WebSocket API connection (limited packet visibility)
+  TCP:    Protocol buffer stream connection (full RF traffic capture)
+
+For maximum RF traffic visibility, use --tcp flag with --host.`,
+	RunE: runDebugger,
+}
+
+func init() {
+	// Connection flags are shared with subcommands
+	// Serial connection flags
+	rootCmd.PersistentFlags().StringVarP(&port, "port", "p", "", "Serial port of Meshtastic device (e.g., COM3)")
+	rootCmd.PersistentFlags().IntVarP(&baud, "baud", "b", 115200, "Baud rate for serial connection")
+	
+	// Network connection flags
+	rootCmd.PersistentFlags().StringVar(&host, "host", "", "IP address or hostname of Meshtastic device (e.g., 192.168.1.100)")
+	rootCmd.PersistentFlags().IntVar(&tcpPort, "tcp-port", 4403, "Port for network connection (80 for HTTP/WiFi, 4403 for TCP protocol buffer stream)")
+	rootCmd.PersistentFlags().BoolVar(&useTCP, "tcp", false, "Use TCP protocol buffer stream for full RF traffic (like Python CLI --listen). Requires --host.")
+	rootCmd.PersistentFlags().DurationVar(&heartbeat, "heartbeat", tcp.DefaultHeartbeatInterval, "Interval between TCP keepalive heartbeats (0 to disable)")
+	rootCmd.PersistentFlags().DurationVar(&stallTimeout, "stall-timeout", tcp.DefaultStallTimeout, "Reconnect a TCP stream that receives nothing for this long (0 to disable)")
+	
+	// Common flags
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose logging")
+	rootCmd.Flags().StringVarP(&filter, "filter", "f", "", "Filter packets (node ID, message type, etc.)")
+	rootCmd.PersistentFlags().StringVar(&nodeDB, "nodedb", meshtastic.DefaultNodeDBPath(), "Path of the persistent node database (empty to disable)")
+	rootCmd.PersistentFlags().StringVar(&keyring, "keyring", "", "File of channel names and base64 PSKs for decrypting relayed packets")
+	rootCmd.PersistentFlags().StringVar(&privKey, "private-key", "", "File holding our node's private key (base64) for decrypting PKI direct messages")
+
+	// Recording flags
+	rootCmd.PersistentFlags().StringVar(&recordPath, "record", "", "Record every frame received to a capture file")
+	rootCmd.PersistentFlags().Int64Var(&recordMaxSize, "record-max-size", 100, "Start a new capture file after this many MB (0 for no limit)")
+	rootCmd.PersistentFlags().DurationVar(&recordRotate, "record-rotate", 0, "Start a new capture file after this long, e.g. 1h (0 for no limit)")
+	rootCmd.PersistentFlags().StringVar(&pcapPath, "pcap", "", "Export mesh packets to a pcapng file for Wireshark")
+
+	// Replay flags
+	rootCmd.Flags().StringVar(&replayPath, "replay", "", "Replay a capture file instead of connecting to a device")
+	rootCmd.Flags().Float64Var(&replaySpeed, "replay-speed", 1, "Replay speed as a multiple of the original timing (0 for as fast as possible)")
+	rootCmd.Flags().BoolVar(&replayPaused, "replay-paused", false, "Start the replay paused, to step through it from the first frame")
+	
+	// Make port and host mutually exclusive but one is required
+	rootCmd.MarkFlagsRequiredTogether()
+}
+
+func runDebugger(cmd *cobra.Command, args []string) error {
+	config, err := buildConfig()
+	if err != nil {
+		return err
+	}
+	
+	// Connection info is logged to mesh-debug.log instead of stdout to avoid TUI corruption
+	
+	debugger := app.NewDebugger(config)
+	return debugger.Run()
+}
+
+// buildConfig validates the connection flags and builds the app configuration
+func buildConfig() (*app.Config, error) {
+	// A replay needs no device
+	if replayPath != "" && (port != "" || host != "") {
+		return nil, fmt.Errorf("cannot specify --replay with --port or --host")
+	}
+	if replaySpeed < 0 {
+		return nil, fmt.Errorf("--replay-speed cannot be negative")
+	}
+	
+	// Validate that either port or host is specified (but not both)
+	if replayPath == "" && port == "" && host == "" {
+		return nil, fmt.Errorf("either --port (for serial) or --host (for network) must be specified, or --replay for a capture file")
+	}
+	if port != "" && host != "" {
+		return nil, fmt.Errorf("cannot specify both --port and --host, choose either serial or network connection")
+	}
+	
+	// Validate TCP flag usage
+	if useTCP && host == "" {
+		return nil, fmt.Errorf("--tcp flag requires --host to be specified")
+	}
+	
+	if heartbeat < 0 || stallTimeout < 0 {
+		return nil, fmt.Errorf("--heartbeat and --stall-timeout cannot be negative")
+	}
+	
+	// Validate recording limits
+	if recordMaxSize < 0 || recordRotate < 0 {
+		return nil, fmt.Errorf("--record-max-size and --record-rotate cannot be negative")
+	}
+	
+	// Set default port based on connection type
+	if host != "" && tcpPort == 4403 && !useTCP {
+		// If host is specified but not using TCP, default to HTTP port
+		tcpPort = 80
+	}
+	
+	// Don't mix replayed nodes into the live NodeDB unless given a path for them
+	nodeDBPath := nodeDB
+	if replayPath != "" && nodeDB == meshtastic.DefaultNodeDBPath() {
+		nodeDBPath = ""
+	}
+	
+	config := &app.Config{
+		// Serial connection
+		Port:    port,
+		Baud:    baud,
+		// Network connection
+		Host:    host,
+		TCPPort: tcpPort,
+		UseTCP:  useTCP,
+		HeartbeatInterval: heartbeat,
+		StallTimeout:      stallTimeout,
+		// Common
+		Verbose:        verbose,
+		Filter:         filter,
+		NodeDBPath:     nodeDBPath,
+		KeyringPath:    keyring,
+		PrivateKeyPath: privKey,
+		// Recording
+		RecordPath:    recordPath,
+		RecordMaxSize: recordMaxSize * 1024 * 1024,
+		RecordRotate:  recordRotate,
+		PcapPath:      pcapPath,
+		// Replay
+		ReplayPath:   replayPath,
+		ReplaySpeed:  replaySpeed,
+		ReplayPaused: replayPaused,
+	}
+	return config, nil
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go-mesh/internal/meshtastic"
+)
+
+var (
+	// NodeDB options
+	pruneOlderThan time.Duration
+	pruneDryRun    bool
+	exportFormat   string
+	exportOutput   string
+)
+
+var nodeDBCmd = &cobra.Command{
+	Use:   "nodedb",
+	Short: "Manage the persistent node database",
+	Long: `Inspect and maintain the node database saved between sessions (see --nodedb).
+
+The debugger loads this file at startup so node names are known before NODEINFO
+broadcasts are heard again, and saves it as nodes are heard and on exit. Don't
+prune while the debugger is running, as it will write back its own copy.`,
+}
+
+var nodeDBPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Remove nodes not heard recently",
+	Args:  cobra.NoArgs,
+	RunE:  runNodeDBPrune,
+}
+
+var nodeDBExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the node database as JSON or CSV",
+	Args:  cobra.NoArgs,
+	RunE:  runNodeDBExport,
+}
+
+func init() {
+	nodeDBPruneCmd.Flags().DurationVar(&pruneOlderThan, "older-than", 30*24*time.Hour, "Remove nodes not heard for this long")
+	nodeDBPruneCmd.Flags().BoolVar(&pruneDryRun, "dry-run", false, "List the nodes that would be removed without changing the file")
+
+	nodeDBExportCmd.Flags().StringVar(&exportFormat, "format", "json", "Output format (json or csv)")
+	nodeDBExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Output file (default stdout)")
+
+	nodeDBCmd.AddCommand(nodeDBPruneCmd, nodeDBExportCmd)
+	rootCmd.AddCommand(nodeDBCmd)
+}
+
+func runNodeDBPrune(cmd *cobra.Command, args []string) error {
+	file, err := readNodeDB()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	db := meshtastic.NewNodeDB()
+	db.Restore(file.Nodes)
+	for _, node := range file.Nodes {
+		if node.IsStale(pruneOlderThan, now) {
+			fmt.Printf("%s %-30s last heard %s\n", node.ID, db.GetNodeName(node.Num), formatLastHeard(node.LastHeard))
+		}
+	}
+
+	if pruneDryRun {
+		fmt.Printf("Would remove %d of %d nodes\n", countStale(file.Nodes, now), len(file.Nodes))
+		return nil
+	}
+
+	pruned := db.Prune(pruneOlderThan, now)
+	if pruned == 0 {
+		fmt.Println("No nodes to remove")
+		return nil
+	}
+	if err := meshtastic.WriteNodeDBFile(nodeDB, db.GetAllNodes()); err != nil {
+		return err
+	}
+	fmt.Printf("Removed %d of %d nodes from %s\n", pruned, len(file.Nodes), nodeDB)
+	return nil
+}
+
+func runNodeDBExport(cmd *cobra.Command, args []string) error {
+	file, err := readNodeDB()
+	if err != nil {
+		return err
+	}
+	sort.Slice(file.Nodes, func(i, j int) bool { return file.Nodes[i].LastHeard.After(file.Nodes[j].LastHeard) })
+
+	out := io.Writer(os.Stdout)
+	if exportOutput != "" {
+		f, err := os.Create(exportOutput)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", exportOutput, err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	switch exportFormat {
+	case "json":
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(file)
+	case "csv":
+		return writeNodesCSV(out, file.Nodes)
+	default:
+		return fmt.Errorf("unknown export format %q, use json or csv", exportFormat)
+	}
+}
+
+// readNodeDB reads the file given by --nodedb
+func readNodeDB() (*meshtastic.NodeDBFile, error) {
+	if nodeDB == "" {
+		return nil, fmt.Errorf("--nodedb path is empty")
+	}
+	file, err := meshtastic.ReadNodeDBFile(nodeDB)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no node database at %s", nodeDB)
+	}
+	return file, err
+}
+
+// writeNodesCSV writes one row per node with the commonly used fields
+func writeNodesCSV(out io.Writer, nodes []*meshtastic.NodeRecord) error {
+	w := csv.NewWriter(out)
+	w.Write([]string{"num", "id", "long_name", "short_name", "hw_model", "role", "first_seen", "last_heard",
+		"hops_away", "snr", "rssi", "latitude", "longitude", "battery"})
+
+	for _, node := range nodes {
+		hops, lat, lon, battery := "", "", "", ""
+		if node.HopsAway != nil {
+			hops = strconv.FormatUint(uint64(*node.HopsAway), 10)
+		}
+		if node.HasPosition() {
+			lat = strconv.FormatFloat(meshtastic.GetLatitudeDegrees(node.Position), 'f', 7, 64)
+			lon = strconv.FormatFloat(meshtastic.GetLongitudeDegrees(node.Position), 'f', 7, 64)
+		}
+		if node.DeviceMetrics != nil && node.DeviceMetrics.BatteryLevel != nil {
+			battery = strconv.FormatUint(uint64(node.DeviceMetrics.GetBatteryLevel()), 10)
+		}
+
+		w.Write([]string{
+			strconv.FormatUint(uint64(node.Num), 10),
+			node.ID,
+			node.LongName,
+			node.ShortName,
+			node.GetHardwareModelName(),
+			node.Role.String(),
+			formatTime(node.FirstSeen),
+			formatTime(node.LastHeard),
+			hops,
+			strconv.FormatFloat(float64(node.LastSNR), 'f', 2, 32),
+			strconv.Itoa(int(node.LastRSSI)),
+			lat,
+			lon,
+			battery,
+		})
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// countStale returns how many nodes would be pruned at now
+func countStale(nodes []*meshtastic.NodeRecord, now time.Time) int {
+	count := 0
+	for _, node := range nodes {
+		if node.IsStale(pruneOlderThan, now) {
+			count++
+		}
+	}
+	return count
+}
+
+// formatTime renders a timestamp as RFC 3339, or empty if unset
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// formatLastHeard renders how long ago a node was heard
+func formatLastHeard(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+	age := time.Since(t)
+	if age >= 48*time.Hour {
+		return fmt.Sprintf("%dd ago", int(age.Hours()/24))
+	}
+	return age.Truncate(time.Minute).String() + " ago"
+}
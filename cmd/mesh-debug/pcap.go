@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go-mesh/internal/pcap"
+)
+
+var (
+	// pcap conversion options
+	pcapOutput string
+)
+
+var pcapCmd = &cobra.Command{
+	Use:   "pcap <capture>",
+	Short: "Convert a capture file to pcapng for Wireshark",
+	Long: `Convert a recording made with --record, including its rotated parts, to a
+pcapng file. Only mesh packets are written; the device's config dump and
+firmware log lines are skipped.
+
+Packets use link type User 0 (DLT 147) with a 28 byte header ahead of the
+FromRadio frame. The header layout is described in internal/pcap/pcapng.go.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPcapConvert,
+}
+
+func init() {
+	pcapCmd.Flags().StringVarP(&pcapOutput, "output", "o", "", "Output file (default the capture name with a .pcapng extension)")
+
+	rootCmd.AddCommand(pcapCmd)
+}
+
+func runPcapConvert(cmd *cobra.Command, args []string) error {
+	input := args[0]
+	output := pcapOutput
+	if output == "" {
+		output = strings.TrimSuffix(input, filepath.Ext(input)) + ".pcapng"
+	}
+	if output == input {
+		return fmt.Errorf("output would overwrite the capture, use --output")
+	}
+	if _, err := os.Stat(input); err != nil {
+		return fmt.Errorf("no capture file at %s", input)
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", output, err)
+	}
+	defer f.Close()
+
+	writer, err := pcap.NewWriter(f, "converted from "+filepath.Base(input))
+	if err != nil {
+		return err
+	}
+	written, err := pcap.ConvertCapture(input, writer)
+	if err != nil {
+		// Packets before a damaged record are still usable
+		fmt.Fprintf(os.Stderr, "Warning: stopped early: %v\n", err)
+	}
+
+	fmt.Printf("Wrote %d packets to %s\n", written, output)
+	return f.Sync()
+}
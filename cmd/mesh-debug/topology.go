@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go-mesh/internal/app"
+)
+
+var (
+	// Topology options
+	topologyListen  time.Duration
+	topologyOutputs []string
+)
+
+var topologyCmd = &cobra.Command{
+	Use:   "topology",
+	Short: "Listen to the mesh and export who can hear whom as DOT or GraphML",
+	Long: `Listen for NeighborInfo packets, traceroute replies and packets our node hears
+without relays, then write the resulting graph of links with their SNR.
+
+Each --output is written as GraphML if it ends in .graphml and as Graphviz DOT
+otherwise, e.g. render mesh.dot with "dot -Tsvg mesh.dot -o mesh.svg". An edge
+A -> B means B has heard A. NeighborInfo is only broadcast by nodes with the
+neighbor info module enabled, so listen for at least its broadcast interval.`,
+	Args: cobra.NoArgs,
+	RunE: runTopology,
+}
+
+func init() {
+	topologyCmd.Flags().DurationVar(&topologyListen, "listen", 15*time.Minute, "How long to listen before writing the graph")
+	topologyCmd.Flags().StringArrayVarP(&topologyOutputs, "output", "o", nil, "File to write, .dot or .graphml (repeatable)")
+	topologyCmd.MarkFlagRequired("output")
+
+	rootCmd.AddCommand(topologyCmd)
+}
+
+func runTopology(cmd *cobra.Command, args []string) error {
+	config, err := buildConfig()
+	if err != nil {
+		return err
+	}
+
+	debugger := app.NewDebugger(config)
+	client, err := debugger.InitClient()
+	if err != nil {
+		return err
+	}
+	defer debugger.Close()
+
+	if err := startAndWaitForConfig(client); err != nil {
+		return err
+	}
+
+	fmt.Printf("Listening for %v...\n", topologyListen)
+	time.Sleep(topologyListen)
+
+	topology := client.GetTopology()
+	fmt.Printf("%d nodes, %d links\n", len(topology.Nodes()), len(topology.Links()))
+	for _, output := range topologyOutputs {
+		if err := topology.Save(output, client.GetNodeDB()); err != nil {
+			return err
+		}
+		fmt.Printf("Wrote %s\n", output)
+	}
+	return nil
+}
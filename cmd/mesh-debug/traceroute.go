@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go-mesh/internal/app"
+	"go-mesh/internal/meshtastic"
+)
+
+var (
+	// Traceroute options
+	tracerouteCount    int
+	tracerouteInterval time.Duration
+	tracerouteTimeout  time.Duration
+	tracerouteHopLimit uint32
+)
+
+// configWaitTimeout bounds how long we wait for the device's config dump,
+// which fills the NodeDB used to name hops
+const configWaitTimeout = 15 * time.Second
+
+var tracerouteCmd = &cobra.Command{
+	Use:   "traceroute <node>",
+	Short: "Trace the route to a node with per-hop SNR",
+	Long: `Send a RouteDiscovery request to a node and print the forward and return
+paths with the SNR of each hop. Requires a serial or --tcp connection.
+
+The node is given as !hex or 0xhex. Use --count to repeat the trace and compare
+runs; firmware rate limits traceroutes, so keep --interval at 30s or more.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTraceroute,
+}
+
+func init() {
+	tracerouteCmd.Flags().IntVarP(&tracerouteCount, "count", "c", 1, "Number of traceroutes to run")
+	tracerouteCmd.Flags().DurationVar(&tracerouteInterval, "interval", 30*time.Second, "Delay between repeated traceroutes")
+	tracerouteCmd.Flags().DurationVar(&tracerouteTimeout, "timeout", meshtastic.DefaultTracerouteTimeout, "How long to wait for each reply")
+	tracerouteCmd.Flags().Uint32Var(&tracerouteHopLimit, "hop-limit", meshtastic.DefaultHopLimit, "Maximum number of hops to the node")
+
+	rootCmd.AddCommand(tracerouteCmd)
+}
+
+func runTraceroute(cmd *cobra.Command, args []string) error {
+	dest, err := meshtastic.ParseNodeID(args[0])
+	if err != nil {
+		return err
+	}
+
+	config, err := buildConfig()
+	if err != nil {
+		return err
+	}
+
+	debugger := app.NewDebugger(config)
+	client, err := debugger.InitClient()
+	if err != nil {
+		return err
+	}
+	defer debugger.Close()
+
+	if err := startAndWaitForConfig(client); err != nil {
+		return err
+	}
+
+	failures := 0
+	for run := 1; run <= tracerouteCount; run++ {
+		if run > 1 {
+			time.Sleep(tracerouteInterval)
+		}
+		if tracerouteCount > 1 {
+			fmt.Printf("--- Run %d/%d ---\n", run, tracerouteCount)
+		}
+
+		result, err := client.Traceroute(dest, tracerouteHopLimit, tracerouteTimeout)
+		if err != nil {
+			fmt.Printf("%v\n\n", err)
+			failures++
+			continue
+		}
+		fmt.Printf("%s\n\n", result.Format(client.GetNodeDB()))
+	}
+
+	if failures == tracerouteCount {
+		return fmt.Errorf("no traceroute reply from !%08x", dest)
+	}
+	return nil
+}
+
+// startAndWaitForConfig starts the client and waits for the config dump to finish
+func startAndWaitForConfig(client *meshtastic.Client) error {
+	if err := client.Start(); err != nil {
+		return fmt.Errorf("failed to start Meshtastic client: %w", err)
+	}
+
+	fmt.Printf("Connected: %s\n", client.GetConnectionInfo())
+	if err := client.WaitForConfig(configWaitTimeout); err != nil {
+		fmt.Printf("Warning: %v, node names may be missing\n", err)
+	}
+	return nil
+}
+
+// startAndRequireConfig starts the client and fails if the config dump
+// doesn't finish, for commands that need every setting
+func startAndRequireConfig(client *meshtastic.Client) error {
+	if err := client.Start(); err != nil {
+		return fmt.Errorf("failed to start Meshtastic client: %w", err)
+	}
+
+	fmt.Printf("Connected: %s\n", client.GetConnectionInfo())
+	if err := client.WaitForConfig(configWaitTimeout); err != nil {
+		return fmt.Errorf("incomplete config from the device: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+	"go-mesh/internal/meshtastic"
+)
+
+var (
+	// Waypoint options
+	waypointID          uint32
+	waypointName        string
+	waypointDescription string
+	waypointIcon        string
+	waypointExpire      time.Duration
+	waypointLocked      bool
+	waypointChannel     uint32
+)
+
+var waypointCmd = &cobra.Command{
+	Use:   "waypoint",
+	Short: "Send, update and delete waypoints shown on the mesh's maps",
+	Long: `Broadcast waypoints on a channel. Apps show them on their maps, and the
+Waypoints view of the TUI lists those received. Requires a serial or --tcp
+connection.`,
+}
+
+var waypointSendCmd = &cobra.Command{
+	Use:   "send <latitude> <longitude>",
+	Short: "Send a new waypoint, or update one with --id",
+	Long: `Send a waypoint at the given position in degrees. Without --id a new
+waypoint is created and its ID printed; with --id the waypoint is replaced, so
+give every field again. A --locked waypoint can only be changed or deleted by
+our node.`,
+	Example: `  mesh-debug --port COM3 waypoint send 47.6062 -122.3321 --name Trailhead --icon 🥾 --expire 48h
+  mesh-debug --port COM3 waypoint send 47.6070 -122.3321 --name Trailhead --id 1234567`,
+	Args: cobra.ExactArgs(2),
+	RunE: runWaypointSend,
+}
+
+var waypointDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Delete a waypoint from the mesh",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runWaypointDelete,
+}
+
+func init() {
+	waypointCmd.PersistentFlags().Uint32Var(&waypointChannel, "channel", 0, "Channel index to send on")
+	waypointSendCmd.Flags().Uint32Var(&waypointID, "id", 0, "ID of the waypoint to update")
+	waypointSendCmd.Flags().StringVar(&waypointName, "name", "", fmt.Sprintf("Name, at most %d bytes", meshtastic.MaxWaypointNameLen))
+	waypointSendCmd.Flags().StringVar(&waypointDescription, "description", "", fmt.Sprintf("Description, at most %d bytes", meshtastic.MaxWaypointDescriptionLen))
+	waypointSendCmd.Flags().StringVar(&waypointIcon, "icon", string(meshtastic.DefaultWaypointIcon), "Emoji shown on maps")
+	waypointSendCmd.Flags().DurationVar(&waypointExpire, "expire", 0, "How long until the waypoint expires, default never")
+	waypointSendCmd.Flags().BoolVar(&waypointLocked, "locked", false, "Only let our node change the waypoint")
+	waypointSendCmd.MarkFlagRequired("name")
+
+	waypointCmd.AddCommand(waypointSendCmd, waypointDeleteCmd)
+	rootCmd.AddCommand(waypointCmd)
+}
+
+func runWaypointSend(cmd *cobra.Command, args []string) error {
+	lat, err := strconv.ParseFloat(args[0], 64)
+	if err != nil || math.Abs(lat) > 90 {
+		return fmt.Errorf("invalid latitude %q", args[0])
+	}
+	lon, err := strconv.ParseFloat(args[1], 64)
+	if err != nil || math.Abs(lon) > 180 {
+		return fmt.Errorf("invalid longitude %q", args[1])
+	}
+	icon, size := utf8.DecodeRuneInString(waypointIcon)
+	if icon == utf8.RuneError || size != len(waypointIcon) {
+		return fmt.Errorf("invalid icon %q, expected a single emoji", waypointIcon)
+	}
+	if waypointExpire < 0 {
+		return fmt.Errorf("--expire must not be negative")
+	}
+
+	waypoint := meshtastic.NewWaypoint(waypointName, waypointDescription, lat, lon)
+	waypoint.Id = waypointID
+	waypoint.Icon = uint32(icon)
+
+	return withDevice(func(client *meshtastic.Client) error {
+		if waypointExpire > 0 {
+			waypoint.Expire = uint32(client.Now().Add(waypointExpire).Unix())
+		}
+		if waypointLocked {
+			waypoint.LockedTo = client.GetMyNodeNum()
+		}
+
+		id, err := client.SendWaypoint(waypoint, waypointChannel)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Sent waypoint %d %q on channel %d\n", id, waypointName, waypointChannel)
+		return nil
+	})
+}
+
+func runWaypointDelete(cmd *cobra.Command, args []string) error {
+	id, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil || id == 0 {
+		return fmt.Errorf("invalid waypoint ID %q", args[0])
+	}
+
+	return withDevice(func(client *meshtastic.Client) error {
+		if err := client.DeleteWaypoint(uint32(id), waypointChannel); err != nil {
+			return err
+		}
+		fmt.Printf("Deleted waypoint %d on channel %d\n", id, waypointChannel)
+		return nil
+	})
+}
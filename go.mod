@@ -9,6 +9,8 @@ require (
 	github.com/gorilla/websocket v1.5.0
 	github.com/spf13/cobra v1.8.0
 	go.bug.st/serial v1.6.1
+	google.golang.org/protobuf v1.36.9
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -33,5 +35,4 @@ require (
 	golang.org/x/sync v0.11.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
 	golang.org/x/text v0.3.8 // indirect
-	google.golang.org/protobuf v1.36.9 // indirect
 )
@@ -1,188 +1,356 @@
-package app
-
-import (
-	"context"
-	"fmt"
-	"log"
-	"os"
-	"os/signal"
-	"syscall"
-
-	tea "github.com/charmbracelet/bubbletea"
-	"go-mesh/internal/meshtastic"
-	"go-mesh/internal/serial"
-	"go-mesh/internal/tcp"
-	"go-mesh/internal/ui"
-	"go-mesh/internal/wifi"
-)
-
-// ConnectionType represents the type of connection to use
-type ConnectionType int
-
-const (
-	ConnectionSerial ConnectionType = iota
-	ConnectionWiFi
-	ConnectionTCP
-)
-
-// Config holds the application configuration
-type Config struct {
-	// Serial connection
-	Port    string
-	Baud    int
-	// Network connections
-	Host    string
-	TCPPort int
-	UseTCP  bool  // Use TCP protocol buffer stream instead of HTTP/WebSocket
-	// Common
-	Verbose bool
-	Filter  string
-}
-
-// GetConnectionType determines the connection type based on configuration
-func (c *Config) GetConnectionType() ConnectionType {
-	if c.Host != "" {
-		if c.UseTCP {
-			return ConnectionTCP
-		}
-		return ConnectionWiFi
-	}
-	return ConnectionSerial
-}
-
-// Debugger represents the main application
-type Debugger struct {
-	config     *Config
-	connection Connection
-	meshtastic *meshtastic.Client
-	ui         *tea.Program
-	logger     *log.Logger
-}
-
-// Connection interface abstracts serial and WiFi connections
-type Connection interface {
-	Connect() error
-	Close() error
-	IsConnected() bool
-	GetConnectionInfo() string
-	StartPacketListener(handler func([]byte) error) error
-	SendCommand(command string) error
-}
-
-// NewDebugger creates a new debugger instance
-func NewDebugger(config *Config) *Debugger {
-	// Create file logger for debugging (in addition to stderr)
-	logFile, err := os.OpenFile("mesh-debug.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-	if err != nil {
-		logFile = os.Stderr
-	}
-	
-	logger := log.New(logFile, "[MESH-DEBUG] ", log.LstdFlags)
-	
-	if !config.Verbose {
-		// Still log to file even when not verbose
-		logger.SetOutput(logFile)
-	}
-
-	return &Debugger{
-		config: config,
-		logger: logger,
-	}
-}
-
-// Run starts the debugger application
-func (d *Debugger) Run() error {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	// Handle interrupt signals
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
-	go func() {
-		<-sigChan
-		d.logger.Println("Received interrupt signal, shutting down...")
-		cancel()
-	}()
-
-	// Initialize connection (serial or WiFi)
-	if err := d.initConnection(); err != nil {
-		return fmt.Errorf("failed to initialize connection: %w", err)
-	}
-	defer d.connection.Close()
-
-	// Initialize Meshtastic client
-	if err := d.initMeshtastic(); err != nil {
-		return fmt.Errorf("failed to initialize Meshtastic client: %w", err)
-	}
-
-	// Initialize and run UI
-	if err := d.initUI(); err != nil {
-		return fmt.Errorf("failed to initialize UI: %w", err)
-	}
-
-	connInfo := d.connection.GetConnectionInfo()
-	d.logger.Printf("Starting Meshtastic debugger: %s", connInfo)
-	
-	// Start the UI in a goroutine
-	uiDone := make(chan error, 1)
-	go func() {
-		_, err := d.ui.Run()
-		uiDone <- err
-	}()
-
-	// Wait for context cancellation or UI completion
-	select {
-	case <-ctx.Done():
-		d.ui.Quit()
-		return nil
-	case err := <-uiDone:
-		return err
-	}
-}
-
-func (d *Debugger) initConnection() error {
-	switch d.config.GetConnectionType() {
-	case ConnectionSerial:
-		conn, err := serial.NewConnection(d.config.Port, d.config.Baud, d.logger)
-		if err != nil {
-			return err
-		}
-		d.connection = conn
-		return d.connection.Connect()
-
-	case ConnectionWiFi:
-		conn, err := wifi.NewConnection(d.config.Host, d.config.TCPPort, d.logger)
-		if err != nil {
-			return err
-		}
-		d.connection = conn
-		return d.connection.Connect()
-
-	case ConnectionTCP:
-		conn, err := tcp.NewConnection(d.config.Host, d.config.TCPPort, d.logger)
-		if err != nil {
-			return err
-		}
-		d.connection = conn
-		return d.connection.Connect()
-
-	default:
-		return fmt.Errorf("unsupported connection type")
-	}
-}
-
-func (d *Debugger) initMeshtastic() error {
-	client, err := meshtastic.NewClient(d.connection, d.logger)
-	if err != nil {
-		return err
-	}
-	
-	d.meshtastic = client
-	return nil
-}
-
-func (d *Debugger) initUI() error {
-	model := ui.NewModel(d.meshtastic, d.config.Filter, d.logger)
-	d.ui = tea.NewProgram(model, tea.WithAltScreen())
-	return nil
-}
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"go-mesh/internal/capture"
+	"go-mesh/internal/meshtastic"
+	"go-mesh/internal/pcap"
+	"go-mesh/internal/replay"
+	"go-mesh/internal/serial"
+	"go-mesh/internal/tcp"
+	"go-mesh/internal/ui"
+	"go-mesh/internal/wifi"
+)
+
+// ConnectionType represents the type of connection to use
+type ConnectionType int
+
+const (
+	ConnectionSerial ConnectionType = iota
+	ConnectionWiFi
+	ConnectionTCP
+	ConnectionReplay
+)
+
+var connectionTypeNames = map[ConnectionType]string{
+	ConnectionSerial: "serial",
+	ConnectionWiFi:   "wifi",
+	ConnectionTCP:    "tcp",
+	ConnectionReplay: "replay",
+}
+
+func (t ConnectionType) String() string {
+	return connectionTypeNames[t]
+}
+
+// Config holds the application configuration
+type Config struct {
+	// Serial connection
+	Port    string
+	Baud    int
+	// Network connections
+	Host    string
+	TCPPort int
+	UseTCP  bool  // Use TCP protocol buffer stream instead of HTTP/WebSocket
+	// TCP stream keepalive, 0 to disable
+	HeartbeatInterval time.Duration
+	StallTimeout      time.Duration
+	// Common
+	Verbose bool
+	Filter  string
+	// NodeDBPath is where the node database is persisted, empty to disable
+	NodeDBPath string
+	// KeyringPath lists channel PSKs for decrypting relayed packets, in
+	// addition to the default key
+	KeyringPath string
+	// PrivateKeyPath holds our node's Curve25519 private key for PKI direct messages
+	PrivateKeyPath string
+	// Capture recording, disabled if RecordPath is empty
+	RecordPath    string
+	RecordMaxSize int64         // Bytes per file before rotating, 0 for no limit
+	RecordRotate  time.Duration // Time per file before rotating, 0 for no limit
+	// Capture replay instead of a device connection, if ReplayPath is set
+	ReplayPath   string
+	ReplaySpeed  float64 // Multiple of the original speed, 0 for as fast as possible
+	ReplayPaused bool
+	// PcapPath starts exporting mesh packets to a pcapng file, if set
+	PcapPath string
+}
+
+// GetConnectionType determines the connection type based on configuration
+func (c *Config) GetConnectionType() ConnectionType {
+	if c.ReplayPath != "" {
+		return ConnectionReplay
+	}
+	if c.Host != "" {
+		if c.UseTCP {
+			return ConnectionTCP
+		}
+		return ConnectionWiFi
+	}
+	return ConnectionSerial
+}
+
+// Debugger represents the main application
+type Debugger struct {
+	config     *Config
+	connection Connection
+	meshtastic *meshtastic.Client
+	nodeStore  *meshtastic.NodeDBStore
+	recorder   *capture.Writer
+	exporter   *pcap.Exporter
+	ui         *tea.Program
+	logger     *log.Logger
+}
+
+// Connection interface abstracts serial and WiFi connections
+type Connection interface {
+	Connect() error
+	Close() error
+	IsConnected() bool
+	GetConnectionInfo() string
+	StartPacketListener(handler func([]byte) error) error
+	SendCommand(command string) error
+}
+
+// NewDebugger creates a new debugger instance
+func NewDebugger(config *Config) *Debugger {
+	// Create file logger for debugging (in addition to stderr)
+	logFile, err := os.OpenFile("mesh-debug.log", os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		logFile = os.Stderr
+	}
+	
+	logger := log.New(logFile, "[MESH-DEBUG] ", log.LstdFlags)
+	
+	if !config.Verbose {
+		// Still log to file even when not verbose
+		logger.SetOutput(logFile)
+	}
+
+	return &Debugger{
+		config: config,
+		logger: logger,
+	}
+}
+
+// Run starts the debugger application
+func (d *Debugger) Run() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Handle interrupt signals
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		d.logger.Println("Received interrupt signal, shutting down...")
+		cancel()
+	}()
+
+	// Initialize connection (serial or WiFi)
+	if err := d.initConnection(); err != nil {
+		return fmt.Errorf("failed to initialize connection: %w", err)
+	}
+	defer d.connection.Close()
+
+	// Initialize Meshtastic client
+	if err := d.initMeshtastic(); err != nil {
+		return fmt.Errorf("failed to initialize Meshtastic client: %w", err)
+	}
+	defer d.closeNodeDB()
+	defer d.closeRecorder()
+	defer d.closeExporter()
+
+	// Initialize and run UI
+	if err := d.initUI(); err != nil {
+		return fmt.Errorf("failed to initialize UI: %w", err)
+	}
+
+	connInfo := d.connection.GetConnectionInfo()
+	d.logger.Printf("Starting Meshtastic debugger: %s", connInfo)
+	
+	// Start the UI in a goroutine
+	uiDone := make(chan error, 1)
+	go func() {
+		_, err := d.ui.Run()
+		uiDone <- err
+	}()
+
+	// Wait for context cancellation or UI completion
+	select {
+	case <-ctx.Done():
+		d.ui.Quit()
+		return nil
+	case err := <-uiDone:
+		return err
+	}
+}
+
+// InitClient connects to the device and creates the Meshtastic client without
+// the TUI, for one-shot subcommands. The caller must Start the client and Close the debugger.
+func (d *Debugger) InitClient() (*meshtastic.Client, error) {
+	if err := d.initConnection(); err != nil {
+		return nil, fmt.Errorf("failed to initialize connection: %w", err)
+	}
+	if err := d.initMeshtastic(); err != nil {
+		d.connection.Close()
+		return nil, fmt.Errorf("failed to initialize Meshtastic client: %w", err)
+	}
+	return d.meshtastic, nil
+}
+
+// Close stops the client and closes the device connection
+func (d *Debugger) Close() error {
+	if d.meshtastic != nil {
+		d.meshtastic.Stop()
+	}
+	d.closeRecorder()
+	d.closeExporter()
+	d.closeNodeDB()
+	if d.connection != nil {
+		return d.connection.Close()
+	}
+	return nil
+}
+
+func (d *Debugger) initConnection() error {
+	switch d.config.GetConnectionType() {
+	case ConnectionSerial:
+		conn, err := serial.NewConnection(d.config.Port, d.config.Baud, d.logger)
+		if err != nil {
+			return err
+		}
+		d.connection = conn
+		return d.connection.Connect()
+
+	case ConnectionWiFi:
+		conn, err := wifi.NewConnection(d.config.Host, d.config.TCPPort, d.logger)
+		if err != nil {
+			return err
+		}
+		d.connection = conn
+		return d.connection.Connect()
+
+	case ConnectionTCP:
+		conn, err := tcp.NewConnection(d.config.Host, d.config.TCPPort, d.logger)
+		if err != nil {
+			return err
+		}
+		conn.HeartbeatInterval = d.config.HeartbeatInterval
+		conn.StallTimeout = d.config.StallTimeout
+		d.connection = conn
+		return d.connection.Connect()
+
+	case ConnectionReplay:
+		conn, err := replay.NewConnection(d.config.ReplayPath, d.config.ReplaySpeed, d.config.ReplayPaused, d.logger)
+		if err != nil {
+			return err
+		}
+		d.connection = conn
+		return d.connection.Connect()
+
+	default:
+		return fmt.Errorf("unsupported connection type")
+	}
+}
+
+func (d *Debugger) initMeshtastic() error {
+	client, err := meshtastic.NewClient(d.connection, d.logger)
+	if err != nil {
+		return err
+	}
+	
+	d.meshtastic = client
+
+	if d.config.KeyringPath != "" {
+		keyring, err := meshtastic.LoadKeyring(d.config.KeyringPath)
+		if err != nil {
+			return fmt.Errorf("failed to load keyring: %w", err)
+		}
+		client.SetKeyring(keyring)
+		d.logger.Printf("Loaded %d channel keys from %s", len(keyring.Entries()), d.config.KeyringPath)
+	}
+	if d.config.PrivateKeyPath != "" {
+		privateKey, err := meshtastic.LoadPrivateKey(d.config.PrivateKeyPath)
+		if err != nil {
+			return fmt.Errorf("failed to load private key: %w", err)
+		}
+		client.SetPrivateKey(privateKey)
+		d.logger.Printf("Loaded private key for public key %s", meshtastic.KeyFingerprint(privateKey.PublicKey().Bytes()))
+	}
+
+	// Restore nodes from the last session so names show before NODEINFO is heard again
+	if d.config.NodeDBPath != "" {
+		d.nodeStore = meshtastic.NewNodeDBStore(client.GetNodeDB(), d.config.NodeDBPath, d.logger)
+		if err := d.nodeStore.Load(); err != nil {
+			d.logger.Printf("Failed to load NodeDB, starting empty: %v", err)
+		}
+		d.nodeStore.Start()
+	}
+
+	if d.config.RecordPath != "" {
+		header := capture.FileHeader{
+			Transport:  d.config.GetConnectionType().String(),
+			Connection: d.connection.GetConnectionInfo(),
+		}
+		rotate := capture.RotateOptions{MaxSize: d.config.RecordMaxSize, MaxAge: d.config.RecordRotate}
+		recorder, err := capture.NewWriter(d.config.RecordPath, header, rotate)
+		if err != nil {
+			d.closeNodeDB()
+			return fmt.Errorf("failed to start recording: %w", err)
+		}
+		d.recorder = recorder
+		client.SetRecorder(recorder)
+		d.logger.Printf("Recording to %s", d.config.RecordPath)
+	}
+
+	// The exporter is always subscribed so the TUI can start and stop it
+	d.exporter = pcap.NewExporter(d.connection.GetConnectionInfo(), d.logger)
+	client.Subscribe(d.exporter)
+	if d.config.PcapPath != "" {
+		if err := d.exporter.Start(d.config.PcapPath); err != nil {
+			d.closeRecorder()
+			d.closeNodeDB()
+			return fmt.Errorf("failed to start pcap export: %w", err)
+		}
+	}
+	return nil
+}
+
+// closeExporter stops any pcapng export in progress
+func (d *Debugger) closeExporter() {
+	if d.exporter == nil {
+		return
+	}
+	if err := d.exporter.Stop(); err != nil {
+		d.logger.Printf("Failed to close pcapng file: %v", err)
+	}
+}
+
+// closeRecorder stops recording and syncs the capture file to disk
+func (d *Debugger) closeRecorder() {
+	if d.recorder == nil {
+		return
+	}
+	d.meshtastic.SetRecorder(nil)
+	if err := d.recorder.Close(); err != nil {
+		d.logger.Printf("Failed to close capture file: %v", err)
+		return
+	}
+	d.logger.Printf("Recorded %d records, last file %s", d.recorder.Records(), d.recorder.CurrentPath())
+}
+
+// closeNodeDB saves the node database if persistence is enabled
+func (d *Debugger) closeNodeDB() {
+	if d.nodeStore == nil {
+		return
+	}
+	if err := d.nodeStore.Close(); err != nil {
+		d.logger.Printf("Failed to save NodeDB: %v", err)
+	}
+}
+
+func (d *Debugger) initUI() error {
+	model := ui.NewModel(d.meshtastic, d.exporter, d.config.Filter, d.logger)
+	d.ui = tea.NewProgram(model, tea.WithAltScreen())
+	return nil
+}
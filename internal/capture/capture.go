@@ -0,0 +1,192 @@
+// Package capture reads and writes recordings of the raw data received from a
+// Meshtastic device.
+//
+// A capture file starts with the 8 byte magic "MESHCAP\x00", a big-endian
+// uint16 format version and a uvarint-length-prefixed JSON FileHeader
+// describing the transport. It is followed by records, each a uvarint length
+// and then the record body: a big-endian int64 receive time in Unix
+// nanoseconds, a one byte RecordKind and the data exactly as it was received.
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Magic identifies a capture file
+const Magic = "MESHCAP\x00"
+
+// Version is the capture format version written by this package
+const Version = 1
+
+// maxRecordSize bounds record lengths so a corrupt file can't cause huge allocations
+const maxRecordSize = 1 << 20
+
+// recordHeaderLen is the size of the timestamp and kind before the data
+const recordHeaderLen = 9
+
+// RecordKind identifies what a record holds
+type RecordKind uint8
+
+const (
+	// RecordFrame is a raw frame passed to the client by Connection.StartPacketListener
+	RecordFrame RecordKind = iota
+	// RecordLog is a firmware debug log line from the serial or TCP stream
+	RecordLog
+)
+
+var RecordKindNames = map[RecordKind]string{
+	RecordFrame: "frame",
+	RecordLog:   "log",
+}
+
+func (k RecordKind) String() string {
+	if name, ok := RecordKindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("kind(%d)", k)
+}
+
+// FileHeader describes the connection a capture was recorded from
+type FileHeader struct {
+	Transport  string    `json:"transport"`  // serial, tcp or wifi
+	Connection string    `json:"connection"` // Connection.GetConnectionInfo at the time of recording
+	Created    time.Time `json:"created"`
+	Part       int       `json:"part"` // Rotation index, 0 for the first file
+}
+
+// Record is one captured frame or log line
+type Record struct {
+	Time time.Time
+	Kind RecordKind
+	Data []byte
+}
+
+// Reader reads records from a capture file
+type Reader struct {
+	Header FileHeader
+	r      *bufio.Reader
+}
+
+// NewReader reads the file header from r
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+
+	magic := make([]byte, len(Magic)+2)
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return nil, fmt.Errorf("failed to read capture header: %w", err)
+	}
+	if string(magic[:len(Magic)]) != Magic {
+		return nil, fmt.Errorf("not a capture file")
+	}
+	if version := binary.BigEndian.Uint16(magic[len(Magic):]); version != Version {
+		return nil, fmt.Errorf("unsupported capture version %d", version)
+	}
+
+	headerJSON, err := readBlock(br)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read capture header: %w", err)
+	}
+
+	reader := &Reader{r: br}
+	if err := json.Unmarshal(headerJSON, &reader.Header); err != nil {
+		return nil, fmt.Errorf("failed to parse capture header: %w", err)
+	}
+	return reader, nil
+}
+
+// Next returns the next record, or io.EOF at the end of the file. A record
+// cut short by a crash while recording returns io.ErrUnexpectedEOF.
+func (r *Reader) Next() (*Record, error) {
+	body, err := readBlock(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) < recordHeaderLen {
+		return nil, fmt.Errorf("capture record too short: %d bytes", len(body))
+	}
+
+	return &Record{
+		Time: time.Unix(0, int64(binary.BigEndian.Uint64(body[:8]))),
+		Kind: RecordKind(body[8]),
+		Data: body[recordHeaderLen:],
+	}, nil
+}
+
+// readBlock reads a uvarint length followed by that many bytes
+func readBlock(r *bufio.Reader) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	if length > maxRecordSize {
+		return nil, fmt.Errorf("capture record of %d bytes exceeds limit", length)
+	}
+
+	block := make([]byte, length)
+	if _, err := io.ReadFull(r, block); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return block, nil
+}
+
+// encodeRecord builds the length-prefixed bytes written for a record
+func encodeRecord(rec *Record) []byte {
+	bodyLen := recordHeaderLen + len(rec.Data)
+	buf := make([]byte, binary.MaxVarintLen64+bodyLen)
+	n := binary.PutUvarint(buf, uint64(bodyLen))
+	binary.BigEndian.PutUint64(buf[n:], uint64(rec.Time.UnixNano()))
+	buf[n+8] = byte(rec.Kind)
+	copy(buf[n+recordHeaderLen:], rec.Data)
+	return buf[:n+bodyLen]
+}
+
+// encodeHeader builds the magic, version and file header
+func encodeHeader(header *FileHeader) ([]byte, error) {
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 0, len(Magic)+2+binary.MaxVarintLen64+len(headerJSON))
+	buf = append(buf, Magic...)
+	buf = binary.BigEndian.AppendUint16(buf, Version)
+	buf = binary.AppendUvarint(buf, uint64(len(headerJSON)))
+	return append(buf, headerJSON...), nil
+}
+
+// PartPath returns the file name used for a rotation part: capture.mcap,
+// capture.1.mcap, capture.2.mcap and so on
+func PartPath(path string, part int) string {
+	if part == 0 {
+		return path
+	}
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s.%d%s", strings.TrimSuffix(path, ext), part, ext)
+}
+
+// Parts returns path followed by any rotated parts that exist, in recording order
+func Parts(path string) []string {
+	parts := []string{path}
+	for part := 1; ; part++ {
+		partPath := PartPath(path, part)
+		if _, err := os.Stat(partPath); err != nil {
+			return parts
+		}
+		parts = append(parts, partPath)
+	}
+}
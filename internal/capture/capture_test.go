@@ -0,0 +1,159 @@
+package capture
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readAll reads every record from a capture file
+func readAll(t *testing.T, path string) (*Reader, []*Record) {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer f.Close()
+
+	reader, err := NewReader(f)
+	if err != nil {
+		t.Fatalf("NewReader failed: %v", err)
+	}
+	var records []*Record
+	for {
+		rec, err := reader.Next()
+		if err == io.EOF {
+			return reader, records
+		}
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		records = append(records, rec)
+	}
+}
+
+// Test that frames and log lines round trip with their timestamps and header
+func TestWriterRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.mcap")
+	rxTime := time.Date(2025, 3, 1, 12, 0, 0, 123456789, time.UTC)
+	os.WriteFile(PartPath(path, 1), []byte("stale part from an earlier recording"), 0644)
+
+	w, err := NewWriter(path, FileHeader{Transport: "tcp", Connection: "TCP: 192.168.1.10:4403"}, RotateOptions{})
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+	w.RecordFrame([]byte{0x0a, 0x01, 0x02}, rxTime)
+	w.RecordLog("INFO | Booting", rxTime.Add(time.Second))
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	if parts := Parts(path); len(parts) != 1 {
+		t.Errorf("Expected stale parts to be removed, got %v", parts)
+	}
+
+	reader, records := readAll(t, path)
+	if reader.Header.Transport != "tcp" || reader.Header.Connection != "TCP: 192.168.1.10:4403" {
+		t.Errorf("Unexpected header: %+v", reader.Header)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+	if records[0].Kind != RecordFrame || !records[0].Time.Equal(rxTime) || !bytes.Equal(records[0].Data, []byte{0x0a, 0x01, 0x02}) {
+		t.Errorf("Unexpected frame record: %+v", records[0])
+	}
+	if records[1].Kind != RecordLog || string(records[1].Data) != "INFO | Booting" {
+		t.Errorf("Unexpected log record: %+v", records[1])
+	}
+}
+
+// Test that the writer rotates by size and every part is readable on its own
+func TestWriterRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.mcap")
+	w, err := NewWriter(path, FileHeader{Transport: "serial"}, RotateOptions{MaxSize: 200})
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if err := w.RecordFrame(bytes.Repeat([]byte{byte(i)}, 50), time.Now()); err != nil {
+			t.Fatalf("RecordFrame failed: %v", err)
+		}
+	}
+	w.Close()
+
+	parts := Parts(path)
+	if len(parts) < 3 || parts[1] != filepath.Join(filepath.Dir(path), "session.1.mcap") {
+		t.Fatalf("Expected rotated parts, got %v", parts)
+	}
+
+	total := 0
+	for i, part := range parts {
+		reader, records := readAll(t, part)
+		if reader.Header.Part != i {
+			t.Errorf("Expected part %d in header, got %d", i, reader.Header.Part)
+		}
+		for _, rec := range records {
+			if rec.Data[0] != byte(total) {
+				t.Errorf("Record %d out of order", total)
+			}
+			total++
+		}
+	}
+	if total != 10 {
+		t.Errorf("Expected 10 records across parts, got %d", total)
+	}
+}
+
+// Test that a rotation that can't create the next part reports why and
+// leaves the writer closed rather than writing to the closed part
+func TestWriterRotationFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.mcap")
+	w, err := NewWriter(path, FileHeader{Transport: "serial"}, RotateOptions{MaxSize: 100})
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+	// A directory in the way of the next part
+	os.Mkdir(PartPath(path, 1), 0755)
+
+	if err := w.RecordFrame(bytes.Repeat([]byte{1}, 50), time.Now()); err != nil {
+		t.Fatalf("RecordFrame failed: %v", err)
+	}
+	err = w.RecordFrame(bytes.Repeat([]byte{2}, 50), time.Now())
+	if err == nil || !strings.Contains(err.Error(), "failed to create capture file") {
+		t.Fatalf("Expected the create error, got %v", err)
+	}
+	if err := w.RecordFrame([]byte{3}, time.Now()); err == nil || !strings.Contains(err.Error(), "closed") {
+		t.Errorf("Expected later writes to fail as closed, got %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("Expected Close to succeed, got %v", err)
+	}
+
+	if _, records := readAll(t, path); len(records) != 1 {
+		t.Errorf("Expected the first part to keep its record, got %d", len(records))
+	}
+}
+
+// Test that a record cut short by a crash is reported rather than returned
+func TestReaderTruncatedRecord(t *testing.T) {
+	header, _ := encodeHeader(&FileHeader{Transport: "serial"})
+	record := encodeRecord(&Record{Time: time.Now(), Kind: RecordFrame, Data: []byte("hello")})
+	data := append(header, record[:len(record)-2]...)
+
+	reader, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("NewReader failed: %v", err)
+	}
+	if _, err := reader.Next(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Expected ErrUnexpectedEOF, got %v", err)
+	}
+
+	if _, err := NewReader(bytes.NewReader([]byte("not a capture"))); err == nil {
+		t.Error("Expected error for non-capture data")
+	}
+}
@@ -0,0 +1,178 @@
+package capture
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// RotateOptions controls when a Writer starts a new file
+type RotateOptions struct {
+	MaxSize int64         // Bytes per file, 0 for no limit
+	MaxAge  time.Duration // Time per file, 0 for no limit
+}
+
+// Writer records frames to a capture file, rotating to numbered parts as
+// configured. It is safe for concurrent use.
+type Writer struct {
+	path   string
+	header FileHeader
+	rotate RotateOptions
+
+	mu      sync.Mutex
+	file    *os.File
+	size    int64
+	opened  time.Time
+	part    int
+	records uint64 // Across all parts
+	inPart  int    // Records in the current part
+	closed  bool
+}
+
+// NewWriter creates the capture file at path, replacing any existing
+// recording with that name including its rotated parts
+func NewWriter(path string, header FileHeader, rotate RotateOptions) (*Writer, error) {
+	for _, stale := range Parts(path)[1:] {
+		os.Remove(stale)
+	}
+
+	w := &Writer{
+		path:   path,
+		header: header,
+		rotate: rotate,
+	}
+	if w.header.Created.IsZero() {
+		w.header.Created = time.Now()
+	}
+	if err := w.openPart(0); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// RecordFrame records a raw frame from the connection
+func (w *Writer) RecordFrame(data []byte, rxTime time.Time) error {
+	return w.Write(&Record{Time: rxTime, Kind: RecordFrame, Data: data})
+}
+
+// RecordLog records a firmware debug log line
+func (w *Writer) RecordLog(line string, rxTime time.Time) error {
+	return w.Write(&Record{Time: rxTime, Kind: RecordLog, Data: []byte(line)})
+}
+
+// Write appends a record, rotating first if the current file is full or too old
+func (w *Writer) Write(rec *Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return fmt.Errorf("capture writer closed")
+	}
+
+	data := encodeRecord(rec)
+	if w.needsRotation(int64(len(data))) {
+		err := w.closeFile()
+		if err == nil {
+			err = w.openPart(w.part + 1)
+		}
+		if err != nil {
+			// The old part is closed and there is no new one to write to
+			w.closed = true
+			return err
+		}
+	}
+
+	n, err := w.file.Write(data)
+	w.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write capture record: %w", err)
+	}
+	w.records++
+	w.inPart++
+	return nil
+}
+
+// Records returns how many records have been written across all parts
+func (w *Writer) Records() uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.records
+}
+
+// CurrentPath returns the file currently being written
+func (w *Writer) CurrentPath() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return PartPath(w.path, w.part)
+}
+
+// Close syncs and closes the current file
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	return w.closeFile()
+}
+
+// needsRotation reports whether a record of n bytes should go in a new file.
+// A file always gets at least one record, so oversized records can't loop.
+func (w *Writer) needsRotation(n int64) bool {
+	if w.inPart == 0 {
+		return false
+	}
+	if w.rotate.MaxSize > 0 && w.size+n > w.rotate.MaxSize {
+		return true
+	}
+	return w.rotate.MaxAge > 0 && time.Since(w.opened) >= w.rotate.MaxAge
+}
+
+// openPart creates a rotation part and writes its header
+func (w *Writer) openPart(part int) error {
+	path := PartPath(w.path, part)
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create capture file: %w", err)
+	}
+
+	header := w.header
+	header.Part = part
+	data, err := encodeHeader(&header)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to encode capture header: %w", err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write capture header: %w", err)
+	}
+
+	w.file = file
+	w.size = int64(len(data))
+	w.opened = time.Now()
+	w.part = part
+	w.inPart = 0
+	return nil
+}
+
+// closeFile flushes the current part to disk and closes it, if one is open
+func (w *Writer) closeFile() error {
+	file := w.file
+	if file == nil {
+		return nil
+	}
+	w.file = nil
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to sync capture file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close capture file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,337 @@
+package meshtastic
+
+import (
+	"fmt"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DefaultAdminTimeout is how long to wait for a reply to an admin request
+const DefaultAdminTimeout = 30 * time.Second
+
+// sessionPasskeyLifetime is how long we reuse a remote node's session passkey.
+// The firmware expires it after 300 seconds, so refresh a little earlier.
+const sessionPasskeyLifetime = 270 * time.Second
+
+// AdminChannelName is the channel used to administer remote nodes running
+// firmware older than 2.5, which predates PKI-encrypted admin messages
+const AdminChannelName = "admin"
+
+// sessionPasskey is the passkey a node sent with its last admin reply
+type sessionPasskey struct {
+	key      []byte
+	received time.Time
+}
+
+// Admin sends AdminMessages to the local device, or over the mesh to a remote node
+type Admin struct {
+	client  *Client
+	node    uint32
+	Timeout time.Duration
+}
+
+// Admin returns an Admin for a node, 0 for the local device
+func (c *Client) Admin(node uint32) *Admin {
+	return &Admin{client: c, node: node, Timeout: DefaultAdminTimeout}
+}
+
+// Node returns the node number being administered
+func (a *Admin) Node() uint32 {
+	if a.node == 0 {
+		return a.client.GetMyNodeNum()
+	}
+	return a.node
+}
+
+// IsRemote returns true if the node is administered over the mesh
+func (a *Admin) IsRemote() bool {
+	return a.node != 0 && a.node != a.client.GetMyNodeNum()
+}
+
+// GetConfig requests one Config section
+func (a *Admin) GetConfig(configType pb.AdminMessage_ConfigType) (*pb.Config, error) {
+	reply, err := a.request(&pb.AdminMessage{
+		PayloadVariant: &pb.AdminMessage_GetConfigRequest{GetConfigRequest: configType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if config := reply.GetGetConfigResponse(); config != nil {
+		return config, nil
+	}
+	return nil, unexpectedReply(reply)
+}
+
+// GetModuleConfig requests one ModuleConfig section
+func (a *Admin) GetModuleConfig(configType pb.AdminMessage_ModuleConfigType) (*pb.ModuleConfig, error) {
+	reply, err := a.request(&pb.AdminMessage{
+		PayloadVariant: &pb.AdminMessage_GetModuleConfigRequest{GetModuleConfigRequest: configType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if config := reply.GetGetModuleConfigResponse(); config != nil {
+		return config, nil
+	}
+	return nil, unexpectedReply(reply)
+}
+
+// GetChannel requests the channel at an index
+func (a *Admin) GetChannel(index uint32) (*pb.Channel, error) {
+	// Sent as index+1, so channel 0 isn't mistaken for an unset field
+	reply, err := a.request(&pb.AdminMessage{
+		PayloadVariant: &pb.AdminMessage_GetChannelRequest{GetChannelRequest: index + 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if channel := reply.GetGetChannelResponse(); channel != nil {
+		return channel, nil
+	}
+	return nil, unexpectedReply(reply)
+}
+
+// GetOwner requests the node's User
+func (a *Admin) GetOwner() (*pb.User, error) {
+	reply, err := a.request(&pb.AdminMessage{
+		PayloadVariant: &pb.AdminMessage_GetOwnerRequest{GetOwnerRequest: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if owner := reply.GetGetOwnerResponse(); owner != nil {
+		return owner, nil
+	}
+	return nil, unexpectedReply(reply)
+}
+
+// GetMetadata requests the node's firmware and hardware details
+func (a *Admin) GetMetadata() (*pb.DeviceMetadata, error) {
+	reply, err := a.request(&pb.AdminMessage{
+		PayloadVariant: &pb.AdminMessage_GetDeviceMetadataRequest{GetDeviceMetadataRequest: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if metadata := reply.GetGetDeviceMetadataResponse(); metadata != nil {
+		return metadata, nil
+	}
+	return nil, unexpectedReply(reply)
+}
+
+// SetConfig replaces one Config section
+func (a *Admin) SetConfig(config *pb.Config) error {
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_SetConfig{SetConfig: config}})
+}
+
+// SetModuleConfig replaces one ModuleConfig section
+func (a *Admin) SetModuleConfig(config *pb.ModuleConfig) error {
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_SetModuleConfig{SetModuleConfig: config}})
+}
+
+// SetChannel replaces the channel at channel.Index
+func (a *Admin) SetChannel(channel *pb.Channel) error {
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_SetChannel{SetChannel: channel}})
+}
+
+// SetOwner sets the node's names and licensed flag
+func (a *Admin) SetOwner(owner *pb.User) error {
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_SetOwner{SetOwner: owner}})
+}
+
+// SetFixedPosition sets a fixed position and turns position.fixed_position on
+func (a *Admin) SetFixedPosition(position *pb.Position) error {
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_SetFixedPosition{SetFixedPosition: position}})
+}
+
+// RemoveFixedPosition clears the fixed position and turns position.fixed_position off
+func (a *Admin) RemoveFixedPosition() error {
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_RemoveFixedPosition{RemoveFixedPosition: true}})
+}
+
+// Reboot reboots the node after a delay in seconds, a negative delay cancels a pending reboot
+func (a *Admin) Reboot(seconds int32) error {
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_RebootSeconds{RebootSeconds: seconds}})
+}
+
+// FactoryReset returns the node's config to factory defaults. A full reset
+// also clears the NodeDB and Bluetooth bonds.
+func (a *Admin) FactoryReset(full bool) error {
+	if full {
+		return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_FactoryResetDevice{FactoryResetDevice: 1}})
+	}
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_FactoryResetConfig{FactoryResetConfig: 1}})
+}
+
+// BeginEditSettings starts a transaction: settings are held until
+// CommitEditSettings instead of each being saved with a reboot
+func (a *Admin) BeginEditSettings() error {
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_BeginEditSettings{BeginEditSettings: true}})
+}
+
+// CommitEditSettings saves the settings changed since BeginEditSettings
+func (a *Admin) CommitEditSettings() error {
+	return a.command(&pb.AdminMessage{PayloadVariant: &pb.AdminMessage_CommitEditSettings{CommitEditSettings: true}})
+}
+
+// request sends an admin request and waits for the node's AdminMessage reply
+func (a *Admin) request(msg *pb.AdminMessage) (*pb.AdminMessage, error) {
+	opts, err := a.sendOptions()
+	if err != nil {
+		return nil, err
+	}
+	opts.WantResponse = true
+
+	var reply *pb.AdminMessage
+	err = a.send(msg, opts, func(packet *Packet) bool {
+		reply, _ = packet.DecodedData.(*AdminMessage)
+		return reply != nil
+	})
+	return reply, err
+}
+
+// command sends a setting or action, which the node acknowledges rather than
+// answers. Remote nodes require a current session passkey.
+func (a *Admin) command(msg *pb.AdminMessage) error {
+	if a.IsRemote() {
+		passkey, err := a.sessionPasskey()
+		if err != nil {
+			return err
+		}
+		msg.SessionPasskey = passkey
+	}
+
+	opts, err := a.sendOptions()
+	if err != nil {
+		return err
+	}
+	opts.WantAck = true
+
+	return a.send(msg, opts, func(packet *Packet) bool {
+		_, isRouting := packet.DecodedData.(*Routing)
+		return isRouting
+	})
+}
+
+// send sends msg and waits for a response accepted by done. Routing errors
+// for the request fail it.
+func (a *Admin) send(msg *pb.AdminMessage, opts SendOptions, done func(*Packet) bool) error {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal AdminMessage: %w", err)
+	}
+
+	responses, cancel, err := a.client.sendAndAwaitResponses(pb.PortNum_ADMIN_APP, payload, opts)
+	if err != nil {
+		return fmt.Errorf("failed to send %s: %w", GetPayloadVariantName(msg), err)
+	}
+	defer cancel()
+
+	deadline := time.NewTimer(a.Timeout)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case packet := <-responses:
+			if routing, ok := packet.DecodedData.(*Routing); ok {
+				if reason := routing.GetErrorReason(); reason != pb.Routing_NONE {
+					return fmt.Errorf("%s to !%08x failed: %s", GetPayloadVariantName(msg), opts.To, reason)
+				}
+			}
+			if done(packet) {
+				return nil
+			}
+
+		case <-deadline.C:
+			return fmt.Errorf("%s to !%08x timed out after %s", GetPayloadVariantName(msg), opts.To, a.Timeout)
+		}
+	}
+}
+
+// sendOptions addresses a packet to the node. Remote nodes are reached on the
+// local "admin" channel if there is one, otherwise with PKI encryption.
+func (a *Admin) sendOptions() (SendOptions, error) {
+	opts := DefaultSendOptions()
+	opts.To = a.Node()
+	if opts.To == 0 {
+		return opts, fmt.Errorf("local node number not received from device yet")
+	}
+
+	if a.IsRemote() {
+		if channel, ok := a.client.channels.ByName(AdminChannelName); ok {
+			opts.Channel = uint32(channel.Index)
+		} else {
+			opts.PKIEncrypted = true
+		}
+	}
+	return opts, nil
+}
+
+// sessionPasskey returns the node's current session passkey, asking for one
+// with a harmless request if we have none or it has expired
+func (a *Admin) sessionPasskey() ([]byte, error) {
+	if passkey, ok := a.client.getSessionPasskey(a.node); ok {
+		return passkey, nil
+	}
+	if _, err := a.GetConfig(pb.AdminMessage_SESSIONKEY_CONFIG); err != nil {
+		return nil, fmt.Errorf("failed to get session passkey: %w", err)
+	}
+	if passkey, ok := a.client.getSessionPasskey(a.node); ok {
+		return passkey, nil
+	}
+	return nil, fmt.Errorf("node !%08x did not send a session passkey", a.node)
+}
+
+// getSessionPasskey returns a node's session passkey if it is still valid
+func (c *Client) getSessionPasskey(node uint32) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	passkey, exists := c.sessionPasskeys[node]
+	if !exists || c.Now().Sub(passkey.received) > sessionPasskeyLifetime {
+		return nil, false
+	}
+	return passkey.key, true
+}
+
+// updateSessionPasskeys remembers the passkey sent with an admin reply
+func (c *Client) updateSessionPasskeys(packet *Packet) {
+	admin, ok := packet.DecodedData.(*AdminMessage)
+	if !ok || len(admin.GetSessionPasskey()) == 0 || packet.From == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessionPasskeys[packet.From] = sessionPasskey{key: admin.GetSessionPasskey(), received: c.Now()}
+}
+
+// unexpectedReply reports an admin reply that doesn't answer the request
+func unexpectedReply(reply *pb.AdminMessage) error {
+	return fmt.Errorf("unexpected admin reply %s", GetPayloadVariantName(reply))
+}
+
+// ConfigTypeFor returns the AdminMessage config type that requests a Config
+// section by name, e.g. "lora". The enum values follow the field numbers of
+// the Config oneof, less one.
+func ConfigTypeFor(section string) (pb.AdminMessage_ConfigType, bool) {
+	number, ok := oneofFieldNumber(&pb.Config{}, section)
+	return pb.AdminMessage_ConfigType(number - 1), ok
+}
+
+// ModuleConfigTypeFor returns the AdminMessage module config type that
+// requests a ModuleConfig section by name, e.g. "mqtt"
+func ModuleConfigTypeFor(section string) (pb.AdminMessage_ModuleConfigType, bool) {
+	number, ok := oneofFieldNumber(&pb.ModuleConfig{}, section)
+	return pb.AdminMessage_ModuleConfigType(number - 1), ok
+}
+
+// oneofFieldNumber returns the field number of a message's oneof field by name
+func oneofFieldNumber(msg proto.Message, name string) (protoreflect.FieldNumber, bool) {
+	field := msg.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(name))
+	if field == nil || field.ContainingOneof() == nil {
+		return 0, false
+	}
+	return field.Number(), true
+}
@@ -0,0 +1,126 @@
+package meshtastic
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// sentAdmin is an AdminMessage captured by adminSender
+type sentAdmin struct {
+	id   uint32
+	msg  *pb.AdminMessage
+	opts SendOptions
+}
+
+// adminSender records the AdminMessages a client sends
+type adminSender struct {
+	*fakeSender
+	sent chan sentAdmin
+}
+
+func (s *adminSender) SendData(portnum pb.PortNum, payload []byte, opts SendOptions) (uint32, error) {
+	packetID, err := s.StreamSender.SendData(portnum, payload, opts)
+	msg := &pb.AdminMessage{}
+	proto.Unmarshal(payload, msg)
+	s.sent <- sentAdmin{id: packetID, msg: msg, opts: opts}
+	return packetID, err
+}
+
+func newAdminTestClient(t *testing.T) (*Client, *adminSender) {
+	t.Helper()
+	sender := &adminSender{fakeSender: newFakeSender(), sent: make(chan sentAdmin, 4)}
+	client, err := NewClient(sender, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	client.myNodeNum = 0x1000
+	return client, sender
+}
+
+// Test that a config request to the local device returns the section from the reply
+func TestAdminGetConfig(t *testing.T) {
+	client, sender := newAdminTestClient(t)
+
+	go func() {
+		sent := <-sender.sent
+		if sent.opts.To != 0x1000 || sent.opts.PKIEncrypted || sent.msg.GetGetConfigRequest() != pb.AdminMessage_LORA_CONFIG {
+			t.Errorf("Unexpected request %v with %+v", sent.msg, sent.opts)
+		}
+		client.dispatchResponse(&Packet{From: 0x1000, RequestID: sent.id, DecodedData: &AdminMessage{
+			PayloadVariant: &pb.AdminMessage_GetConfigResponse{GetConfigResponse: &pb.Config{
+				PayloadVariant: &pb.Config_Lora{Lora: &pb.Config_LoRaConfig{HopLimit: 5}},
+			}},
+		}})
+	}()
+
+	configType, _ := ConfigTypeFor("lora")
+	config, err := client.Admin(0).GetConfig(configType)
+	if err != nil {
+		t.Fatalf("GetConfig failed: %v", err)
+	}
+	if config.GetLora().GetHopLimit() != 5 {
+		t.Errorf("Expected lora config with hop limit 5, got %v", config)
+	}
+}
+
+// Test that a setting sent to a remote node fetches and carries its session
+// passkey, and waits for the node's ack
+func TestAdminRemoteSetConfig(t *testing.T) {
+	client, sender := newAdminTestClient(t)
+	passkey := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+
+	go func() {
+		request := <-sender.sent
+		if request.msg.GetGetConfigRequest() != pb.AdminMessage_SESSIONKEY_CONFIG || !request.opts.PKIEncrypted {
+			t.Errorf("Expected PKI session key request, got %v with %+v", request.msg, request.opts)
+		}
+		reply := &Packet{From: 0x2000, RequestID: request.id, DecodedData: &AdminMessage{
+			SessionPasskey: passkey,
+			PayloadVariant: &pb.AdminMessage_GetConfigResponse{GetConfigResponse: &pb.Config{
+				PayloadVariant: &pb.Config_Sessionkey{Sessionkey: &pb.Config_SessionkeyConfig{}},
+			}},
+		}}
+		client.updateSessionPasskeys(reply)
+		client.dispatchResponse(reply)
+
+		set := <-sender.sent
+		if !bytes.Equal(set.msg.GetSessionPasskey(), passkey) || !set.opts.WantAck {
+			t.Errorf("Expected set_config with passkey and want_ack, got %v with %+v", set.msg, set.opts)
+		}
+		client.dispatchResponse(&Packet{From: 0x2000, RequestID: set.id, DecodedData: routingError(pb.Routing_NONE)})
+	}()
+
+	config := &pb.Config{PayloadVariant: &pb.Config_Lora{Lora: &pb.Config_LoRaConfig{}}}
+	if err := SetConfigValue(config, "lora.region", "eu_868"); err != nil {
+		t.Fatalf("SetConfigValue failed: %v", err)
+	}
+	if config.GetLora().GetRegion() != pb.Config_LoRaConfig_EU_868 {
+		t.Fatalf("Expected region EU_868, got %v", config.GetLora().GetRegion())
+	}
+
+	admin := client.Admin(0x2000)
+	admin.Timeout = time.Second
+	if err := admin.SetConfig(config); err != nil {
+		t.Fatalf("SetConfig failed: %v", err)
+	}
+}
+
+// Test that a nak from the node fails the admin command
+func TestAdminCommandNak(t *testing.T) {
+	client, sender := newAdminTestClient(t)
+
+	go func() {
+		sent := <-sender.sent
+		client.dispatchResponse(&Packet{From: 0x1000, RequestID: sent.id, DecodedData: routingError(pb.Routing_NOT_AUTHORIZED)})
+	}()
+
+	if err := client.Admin(0).Reboot(5); err == nil {
+		t.Error("Expected NOT_AUTHORIZED error")
+	}
+}
@@ -0,0 +1,471 @@
+package meshtastic
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"gopkg.in/yaml.v3"
+)
+
+// unsavedSections are sent in the config dump but not written with set_config
+var unsavedSections = map[string]bool{
+	"sessionkey": true,
+	"device_ui":  true,
+}
+
+// discardEditRebootSeconds is the reboot delay used to drop an edit
+// transaction that failed part way
+const discardEditRebootSeconds = 1
+
+// ConfigBackup is a device's settings: its owner names, Config and
+// ModuleConfig sections and channels
+type ConfigBackup struct {
+	Owner    *pb.User                 // Only the long and short name are kept
+	Sections map[string]proto.Message // Section messages by oneof field name, e.g. "lora"
+	Channels []*pb.Channel            // In index order
+}
+
+// ConfigChange is a setting that differs between two backups
+type ConfigChange struct {
+	Section string // "owner", a config section name or "channel.N"
+	Field   string // Path within the section, e.g. "settings.name"
+	Old     string
+	New     string
+}
+
+// String formats the change as section.field: old -> new
+func (c ConfigChange) String() string {
+	return fmt.Sprintf("%s.%s: %s -> %s", c.Section, c.Field, c.Old, c.New)
+}
+
+// ConfigBackup returns the device's settings from the config dump
+func (c *Client) ConfigBackup() *ConfigBackup {
+	backup := &ConfigBackup{
+		Sections: c.config.messages(),
+		Channels: c.channels.All(),
+	}
+	for name := range unsavedSections {
+		delete(backup.Sections, name)
+	}
+	if user := c.getLocalUser(); user != nil && (user.LongName != "" || user.ShortName != "") {
+		backup.Owner = &pb.User{LongName: user.LongName, ShortName: user.ShortName}
+	}
+	return backup
+}
+
+// clone returns a deep copy of the backup
+func (b *ConfigBackup) clone() *ConfigBackup {
+	clone := &ConfigBackup{Sections: make(map[string]proto.Message, len(b.Sections))}
+	if b.Owner != nil {
+		clone.Owner = proto.Clone(b.Owner).(*pb.User)
+	}
+	for name, msg := range b.Sections {
+		clone.Sections[name] = proto.Clone(msg)
+	}
+	for _, channel := range b.Channels {
+		clone.Channels = append(clone.Channels, proto.Clone(channel).(*pb.Channel))
+	}
+	return clone
+}
+
+// Channel returns the channel at an index, or nil
+func (b *ConfigBackup) Channel(index int32) *pb.Channel {
+	for _, channel := range b.Channels {
+		if channel.GetIndex() == index {
+			return channel
+		}
+	}
+	return nil
+}
+
+// MarshalBackup writes a backup as YAML. Every setting is written so the
+// file restores the complete config. The device's key pair is left out
+// unless includeKeys is set, as cloning it gives two nodes the same identity.
+func MarshalBackup(backup *ConfigBackup, includeKeys bool) ([]byte, error) {
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	if backup.Owner != nil {
+		owner := &yaml.Node{Kind: yaml.MappingNode}
+		appendYAMLPair(owner, "long_name", stringNode(backup.Owner.GetLongName()))
+		appendYAMLPair(owner, "short_name", stringNode(backup.Owner.GetShortName()))
+		appendYAMLPair(doc, "owner", owner)
+	}
+
+	config := &yaml.Node{Kind: yaml.MappingNode}
+	modules := &yaml.Node{Kind: yaml.MappingNode}
+	for _, fd := range sectionFields() {
+		name := string(fd.Name())
+		msg, exists := backup.Sections[name]
+		if !exists {
+			continue
+		}
+		if isModuleSection(fd) {
+			appendYAMLPair(modules, name, messageNode(msg.ProtoReflect(), includeKeys))
+		} else {
+			appendYAMLPair(config, name, messageNode(msg.ProtoReflect(), includeKeys))
+		}
+	}
+	if len(config.Content) > 0 {
+		appendYAMLPair(doc, "config", config)
+	}
+	if len(modules.Content) > 0 {
+		appendYAMLPair(doc, "module_config", modules)
+	}
+
+	if len(backup.Channels) > 0 {
+		channels := &yaml.Node{Kind: yaml.SequenceNode}
+		for _, channel := range backup.Channels {
+			if channel.GetRole() == pb.Channel_DISABLED {
+				// Disabled slots only need to stay disabled
+				node := &yaml.Node{Kind: yaml.MappingNode}
+				appendYAMLPair(node, "index", &yaml.Node{Kind: yaml.ScalarNode, Value: strconv.Itoa(int(channel.GetIndex()))})
+				appendYAMLPair(node, "role", stringNode(channel.GetRole().String()))
+				channels.Content = append(channels.Content, node)
+				continue
+			}
+			channels.Content = append(channels.Content, messageNode(channel.ProtoReflect(), true))
+		}
+		appendYAMLPair(doc, "channels", channels)
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// messageNode converts a message to a YAML mapping in field order, with the
+// same value formats SetConfigValue parses. Deprecated fields are skipped.
+func messageNode(msg protoreflect.Message, includeKeys bool) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if options, ok := fd.Options().(*descriptorpb.FieldOptions); ok && options.GetDeprecated() {
+			continue
+		}
+		if uniqueConfigFields[string(fd.Name())] && !includeKeys {
+			continue
+		}
+
+		var value *yaml.Node
+		switch {
+		case fd.IsMap():
+			continue
+		case fd.IsList():
+			value = &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+			list := msg.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				value.Content = append(value.Content, scalarNode(fd, list.Get(j)))
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			value = messageNode(msg.Get(fd).Message(), includeKeys)
+		default:
+			value = scalarNode(fd, msg.Get(fd))
+		}
+		appendYAMLPair(node, string(fd.Name()), value)
+	}
+	return node
+}
+
+// scalarNode converts a field value, quoting strings that would read as another type
+func scalarNode(fd protoreflect.FieldDescriptor, v protoreflect.Value) *yaml.Node {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return stringNode(v.String())
+	case protoreflect.EnumKind, protoreflect.BytesKind:
+		return stringNode(formatScalar(fd, v))
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: v.String()}
+	}
+}
+
+func stringNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+func appendYAMLPair(mapping *yaml.Node, key string, value *yaml.Node) {
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+// UnmarshalBackup applies a YAML backup on top of base, normally the device's
+// current settings, and returns the result. Settings missing from the file
+// keep their value from base, so a file may hold just the settings to change.
+func UnmarshalBackup(data []byte, base *ConfigBackup) (*ConfigBackup, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	backup := base.clone()
+	if len(doc.Content) == 0 {
+		return backup, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("line %d: expected a mapping of owner, config, module_config and channels", root.Line)
+	}
+
+	for i := 0; i < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		var err error
+		switch key.Value {
+		case "owner":
+			if backup.Owner == nil {
+				backup.Owner = &pb.User{}
+			}
+			err = applyYAML(backup.Owner, "owner", value)
+		case "config", "module_config":
+			err = backup.applySections(key.Value == "module_config", value)
+		case "channels":
+			err = backup.applyChannels(value)
+		default:
+			err = fmt.Errorf("line %d: unknown key %q", key.Line, key.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return backup, nil
+}
+
+// applySections applies a mapping of section names to settings
+func (b *ConfigBackup) applySections(module bool, node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected a mapping of sections", node.Line)
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		name := key.Value
+		fd, ok := sectionField(name)
+		if !ok || isModuleSection(fd) != module || unsavedSections[name] {
+			return fmt.Errorf("line %d: unknown section %q", key.Line, name)
+		}
+
+		msg, exists := b.Sections[name]
+		if !exists {
+			msg = newSection(fd)
+			b.Sections[name] = msg
+		}
+		if err := applyYAML(msg, name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyChannels applies a sequence of channels, matched by index
+func (b *ConfigBackup) applyChannels(node *yaml.Node) error {
+	if node.Kind != yaml.SequenceNode {
+		return fmt.Errorf("line %d: expected a list of channels", node.Line)
+	}
+	for _, item := range node.Content {
+		index, err := yamlChannelIndex(item)
+		if err != nil {
+			return err
+		}
+
+		channel := b.Channel(index)
+		if channel == nil {
+			channel = &pb.Channel{Index: index}
+			b.Channels = append(b.Channels, channel)
+			sort.Slice(b.Channels, func(i, j int) bool { return b.Channels[i].GetIndex() < b.Channels[j].GetIndex() })
+		}
+		if err := applyYAML(channel, fmt.Sprintf("channel.%d", index), item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// yamlChannelIndex returns the index key of a channel mapping
+func yamlChannelIndex(node *yaml.Node) (int32, error) {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i < len(node.Content); i += 2 {
+			if node.Content[i].Value != "index" {
+				continue
+			}
+			index, err := strconv.ParseInt(node.Content[i+1].Value, 10, 32)
+			if err != nil || index < 0 || index >= MaxChannels {
+				return 0, fmt.Errorf("line %d: invalid channel index %q", node.Content[i+1].Line, node.Content[i+1].Value)
+			}
+			return int32(index), nil
+		}
+	}
+	return 0, fmt.Errorf("line %d: channel without an index", node.Line)
+}
+
+// applyYAML sets each setting of a YAML mapping on msg with SetConfigValue,
+// nested mappings giving dotted paths and lists comma separated values
+func applyYAML(msg proto.Message, section string, node *yaml.Node) error {
+	return applyYAMLPath(msg, section, "", node)
+}
+
+func applyYAMLPath(msg proto.Message, section, prefix string, node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expected the settings of %s", node.Line, strings.TrimSuffix(section+"."+prefix, "."))
+	}
+	for i := 0; i < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		path := prefix + key.Value
+
+		var err error
+		switch value.Kind {
+		case yaml.MappingNode:
+			err = applyYAMLPath(msg, section, path+".", value)
+		case yaml.SequenceNode:
+			items := make([]string, len(value.Content))
+			for j, item := range value.Content {
+				items[j] = item.Value
+			}
+			err = SetConfigValue(msg, path, strings.Join(items, ","))
+		default:
+			err = SetConfigValue(msg, path, value.Value)
+		}
+		if err != nil {
+			return fmt.Errorf("line %d: %s: %w", key.Line, section, err)
+		}
+	}
+	return nil
+}
+
+// DiffBackups lists the settings that differ from one backup to another:
+// owner first, then sections in protobuf field order, then channels by index.
+// Sections and channels missing from to are not compared.
+func DiffBackups(from, to *ConfigBackup) []ConfigChange {
+	var changes []ConfigChange
+	if to.Owner != nil {
+		old := from.Owner
+		if old == nil {
+			old = &pb.User{}
+		}
+		changes = appendChanges(changes, "owner", "", old.ProtoReflect(), to.Owner.ProtoReflect())
+	}
+
+	for _, fd := range sectionFields() {
+		name := string(fd.Name())
+		msg, exists := to.Sections[name]
+		if !exists {
+			continue
+		}
+		old, exists := from.Sections[name]
+		if !exists {
+			old = newSection(fd)
+		}
+		changes = appendChanges(changes, name, "", old.ProtoReflect(), msg.ProtoReflect())
+	}
+
+	for _, channel := range to.Channels {
+		old := from.Channel(channel.GetIndex())
+		if old == nil {
+			old = &pb.Channel{Index: channel.GetIndex()}
+		}
+		section := fmt.Sprintf("channel.%d", channel.GetIndex())
+		changes = appendChanges(changes, section, "", old.ProtoReflect(), channel.ProtoReflect())
+	}
+	return changes
+}
+
+func appendChanges(changes []ConfigChange, section, prefix string, from, to protoreflect.Message) []ConfigChange {
+	fields := to.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			continue
+		}
+		name := prefix + string(fd.Name())
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() {
+			changes = appendChanges(changes, section, name+".", from.Get(fd).Message(), to.Get(fd).Message())
+			continue
+		}
+
+		old := formatConfigValue(fd, from.Get(fd))
+		value := formatConfigValue(fd, to.Get(fd))
+		if old == value {
+			continue
+		}
+		if secretConfigFields[string(fd.Name())] {
+			old, value = maskSecret(old), maskSecret(value)
+		}
+		changes = append(changes, ConfigChange{Section: section, Field: name, Old: old, New: value})
+	}
+	return changes
+}
+
+// ApplyBackup writes the sections of target with changes to the node in one
+// edit transaction, so the node saves and reboots once. There is no message
+// to abort a transaction and the sections already written are live until the
+// node reboots, so if a section fails the node is rebooted without committing
+// to drop them and go back to its saved settings.
+func (a *Admin) ApplyBackup(target *ConfigBackup, changes []ConfigChange) error {
+	var sections []string
+	seen := make(map[string]bool)
+	for _, change := range changes {
+		if !seen[change.Section] {
+			seen[change.Section] = true
+			sections = append(sections, change.Section)
+		}
+	}
+	if len(sections) == 0 {
+		return nil
+	}
+
+	if err := a.BeginEditSettings(); err != nil {
+		return err
+	}
+	for _, section := range sections {
+		if err := a.applySection(target, section); err != nil {
+			if rebootErr := a.Reboot(discardEditRebootSeconds); rebootErr != nil {
+				return fmt.Errorf("failed to set %s: %w; rebooting to discard the uncommitted settings also failed: %v",
+					section, err, rebootErr)
+			}
+			return fmt.Errorf("failed to set %s, rebooting the node to discard the uncommitted settings: %w", section, err)
+		}
+	}
+	return a.CommitEditSettings()
+}
+
+// applySection writes one section of a backup to the node
+func (a *Admin) applySection(target *ConfigBackup, section string) error {
+	if section == "owner" {
+		// Only the names are backed up, keep the node's other owner fields
+		owner, err := a.GetOwner()
+		if err != nil {
+			return err
+		}
+		proto.Merge(owner, target.Owner)
+		return a.SetOwner(owner)
+	}
+
+	if index, found := strings.CutPrefix(section, "channel."); found {
+		n, _ := strconv.Atoi(index)
+		channel := target.Channel(int32(n))
+		if channel == nil {
+			return fmt.Errorf("no channel %d in backup", n)
+		}
+		return a.SetChannel(channel)
+	}
+
+	config, err := wrapSection(section, target.Sections[section])
+	if err != nil {
+		return err
+	}
+	switch config := config.(type) {
+	case *pb.Config:
+		return a.SetConfig(config)
+	case *pb.ModuleConfig:
+		return a.SetModuleConfig(config)
+	}
+	return fmt.Errorf("unknown config section %q", section)
+}
@@ -0,0 +1,147 @@
+package meshtastic
+
+import (
+	"strings"
+	"testing"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+func testBackup() *ConfigBackup {
+	return &ConfigBackup{
+		Owner: &pb.User{LongName: "Hilltop Relay", ShortName: "HILL"},
+		Sections: map[string]proto.Message{
+			"lora": &pb.Config_LoRaConfig{UsePreset: true, HopLimit: 5, Region: pb.Config_LoRaConfig_EU_868},
+			"security": &pb.Config_SecurityConfig{
+				PrivateKey: []byte{1, 2, 3},
+				AdminKey:   [][]byte{{4, 5, 6}},
+			},
+			"mqtt": &pb.ModuleConfig_MQTTConfig{Enabled: true, Address: "true"},
+		},
+		Channels: []*pb.Channel{
+			{Index: 0, Role: pb.Channel_PRIMARY, Settings: &pb.ChannelSettings{Psk: []byte{1}}},
+			{Index: 1, Role: pb.Channel_DISABLED},
+		},
+	}
+}
+
+// Test that an exported backup imported onto a blank device restores every
+// setting but the device's keys
+func TestBackupRoundTrip(t *testing.T) {
+	backup := testBackup()
+	data, err := MarshalBackup(backup, false)
+	if err != nil {
+		t.Fatalf("MarshalBackup failed: %v", err)
+	}
+	if strings.Contains(string(data), "private_key") {
+		t.Errorf("Expected the private key to be left out:\n%s", data)
+	}
+
+	restored, err := UnmarshalBackup(data, &ConfigBackup{Sections: map[string]proto.Message{}})
+	if err != nil {
+		t.Fatalf("UnmarshalBackup failed: %v\n%s", err, data)
+	}
+	backup.Sections["security"].(*pb.Config_SecurityConfig).PrivateKey = nil
+	if changes := DiffBackups(backup, restored); len(changes) != 0 {
+		t.Errorf("Expected restored backup to match, got changes %v\n%s", changes, data)
+	}
+	if len(restored.Channels) != 2 || restored.Channels[1].GetRole() != pb.Channel_DISABLED {
+		t.Errorf("Expected both channels restored, got %v", restored.Channels)
+	}
+}
+
+// Test that a partial file changes only the settings it lists
+func TestBackupPartialImport(t *testing.T) {
+	live := testBackup()
+	data := []byte(`
+config:
+  lora:
+    hop_limit: 3
+channels:
+  - index: 1
+    role: SECONDARY
+    settings:
+      name: ops
+`)
+	target, err := UnmarshalBackup(data, live)
+	if err != nil {
+		t.Fatalf("UnmarshalBackup failed: %v", err)
+	}
+
+	var got []string
+	for _, change := range DiffBackups(live, target) {
+		got = append(got, change.String())
+	}
+	want := []string{
+		"lora.hop_limit: 5 -> 3",
+		`channel.1.settings.name: "" -> "ops"`,
+		"channel.1.role: DISABLED -> SECONDARY",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected changes:\n%s\ngot:\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+	if live.Sections["lora"].(*pb.Config_LoRaConfig).GetHopLimit() != 5 {
+		t.Error("Expected the live backup to be left unchanged")
+	}
+
+	if _, err := UnmarshalBackup([]byte("config:\n  mqtt:\n    enabled: true\n"), live); err == nil {
+		t.Error("Expected an error for a module section under config")
+	}
+}
+
+// Test that changed sections are written in one edit transaction
+func TestApplyBackup(t *testing.T) {
+	client, sender := newAdminTestClient(t)
+	live := testBackup()
+	target, err := UnmarshalBackup([]byte("config:\n  lora:\n    hop_limit: 7\n"), live)
+	if err != nil {
+		t.Fatalf("UnmarshalBackup failed: %v", err)
+	}
+
+	go func() {
+		for _, expect := range []string{"begin_edit_settings", "set_config", "commit_edit_settings"} {
+			sent := <-sender.sent
+			if variant := GetPayloadVariantName(sent.msg); variant != expect {
+				t.Errorf("Expected %s, got %s", expect, variant)
+			}
+			if expect == "set_config" && sent.msg.GetSetConfig().GetLora().GetHopLimit() != 7 {
+				t.Errorf("Expected lora config with hop limit 7, got %v", sent.msg)
+			}
+			client.dispatchResponse(&Packet{From: 0x1000, RequestID: sent.id, DecodedData: routingError(pb.Routing_NONE)})
+		}
+	}()
+
+	if err := client.Admin(0).ApplyBackup(target, DiffBackups(live, target)); err != nil {
+		t.Fatalf("ApplyBackup failed: %v", err)
+	}
+}
+
+// Test that a failed section reboots the node instead of committing the others
+func TestApplyBackupFailure(t *testing.T) {
+	client, sender := newAdminTestClient(t)
+	live := testBackup()
+	target, err := UnmarshalBackup([]byte("config:\n  lora:\n    hop_limit: 7\n"), live)
+	if err != nil {
+		t.Fatalf("UnmarshalBackup failed: %v", err)
+	}
+
+	go func() {
+		for _, expect := range []string{"begin_edit_settings", "set_config", "reboot_seconds"} {
+			sent := <-sender.sent
+			if variant := GetPayloadVariantName(sent.msg); variant != expect {
+				t.Errorf("Expected %s, got %s", expect, variant)
+			}
+			reason := pb.Routing_NONE
+			if expect == "set_config" {
+				reason = pb.Routing_ADMIN_BAD_SESSION_KEY
+			}
+			client.dispatchResponse(&Packet{From: 0x1000, RequestID: sent.id, DecodedData: routingError(reason)})
+		}
+	}()
+
+	err = client.Admin(0).ApplyBackup(target, DiffBackups(live, target))
+	if err == nil || !strings.Contains(err.Error(), "rebooting the node") {
+		t.Fatalf("Expected the failure to reboot the node, got %v", err)
+	}
+}
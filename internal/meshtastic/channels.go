@@ -0,0 +1,199 @@
+package meshtastic
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxChannels is the number of channel slots on a device
+const MaxChannels = 8
+
+// modemPresetNames are the names the firmware gives a channel left unnamed
+var modemPresetNames = map[pb.Config_LoRaConfig_ModemPreset]string{
+	pb.Config_LoRaConfig_LONG_FAST:      "LongFast",
+	pb.Config_LoRaConfig_LONG_SLOW:      "LongSlow",
+	pb.Config_LoRaConfig_VERY_LONG_SLOW: "VLongSlow",
+	pb.Config_LoRaConfig_MEDIUM_SLOW:    "MediumSlow",
+	pb.Config_LoRaConfig_MEDIUM_FAST:    "MediumFast",
+	pb.Config_LoRaConfig_SHORT_SLOW:     "ShortSlow",
+	pb.Config_LoRaConfig_SHORT_FAST:     "ShortFast",
+	pb.Config_LoRaConfig_LONG_MODERATE:  "LongMod",
+	pb.Config_LoRaConfig_SHORT_TURBO:    "ShortTurbo",
+}
+
+// ModemPresetName returns the channel name used for a modem preset
+func ModemPresetName(preset pb.Config_LoRaConfig_ModemPreset) string {
+	if name, ok := modemPresetNames[preset]; ok {
+		return name
+	}
+	return preset.String()
+}
+
+// ChannelInfo is a channel configured on the device
+type ChannelInfo struct {
+	Index int32
+	Role  pb.Channel_Role
+	Name  string // As shown by the firmware, the preset name if left empty
+	PSK   []byte
+	Hash  uint8
+}
+
+// ChannelTable holds the device's channels from the config dump, so packets
+// can be labelled with channel names. It is safe for concurrent use.
+type ChannelTable struct {
+	mu       sync.RWMutex
+	channels map[int32]*pb.Channel
+	lora     *pb.Config_LoRaConfig
+}
+
+// NewChannelTable creates an empty channel table
+func NewChannelTable() *ChannelTable {
+	return &ChannelTable{channels: make(map[int32]*pb.Channel)}
+}
+
+// UpdateChannel stores a Channel from the config dump
+func (t *ChannelTable) UpdateChannel(channel *pb.Channel) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.channels[channel.GetIndex()] = channel
+}
+
+// UpdateLoRaConfig stores the LoRa config, which names unnamed channels
+func (t *ChannelTable) UpdateLoRaConfig(lora *pb.Config_LoRaConfig) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lora = lora
+}
+
+// Channels returns the enabled channels in index order
+func (t *ChannelTable) Channels() []ChannelInfo {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	channels := make([]ChannelInfo, 0, len(t.channels))
+	for _, channel := range t.channels {
+		if channel.GetRole() == pb.Channel_DISABLED {
+			continue
+		}
+		channels = append(channels, t.info(channel))
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].Index < channels[j].Index })
+	return channels
+}
+
+// All returns copies of the channels from the config dump, disabled ones
+// included, in index order
+func (t *ChannelTable) All() []*pb.Channel {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	channels := make([]*pb.Channel, 0, len(t.channels))
+	for _, channel := range t.channels {
+		channels = append(channels, proto.Clone(channel).(*pb.Channel))
+	}
+	sort.Slice(channels, func(i, j int) bool { return channels[i].GetIndex() < channels[j].GetIndex() })
+	return channels
+}
+
+// ByIndex returns the enabled channel at an index
+func (t *ChannelTable) ByIndex(index int32) (ChannelInfo, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	channel, exists := t.channels[index]
+	if !exists || channel.GetRole() == pb.Channel_DISABLED {
+		return ChannelInfo{}, false
+	}
+	return t.info(channel), true
+}
+
+// ByHash returns the first enabled channel with a channel hash
+func (t *ChannelTable) ByHash(hash uint8) (ChannelInfo, bool) {
+	for _, channel := range t.Channels() {
+		if channel.Hash == hash {
+			return channel, true
+		}
+	}
+	return ChannelInfo{}, false
+}
+
+// ByName returns the enabled channel with a name
+func (t *ChannelTable) ByName(name string) (ChannelInfo, bool) {
+	for _, channel := range t.Channels() {
+		if channel.Name == name {
+			return channel, true
+		}
+	}
+	return ChannelInfo{}, false
+}
+
+// info resolves a channel's name and hash. Callers hold t.mu.
+func (t *ChannelTable) info(channel *pb.Channel) ChannelInfo {
+	settings := channel.GetSettings()
+	info := ChannelInfo{
+		Index: channel.GetIndex(),
+		Role:  channel.GetRole(),
+		Name:  settings.GetName(),
+		PSK:   settings.GetPsk(),
+	}
+	if info.Name == "" {
+		info.Name = t.presetName()
+	}
+	key, _ := ExpandPSK(info.PSK)
+	info.Hash = ChannelHash(info.Name, key)
+	return info
+}
+
+// presetName is the name of an unnamed channel. Callers hold t.mu.
+func (t *ChannelTable) presetName() string {
+	if t.lora != nil && !t.lora.GetUsePreset() {
+		return "Custom"
+	}
+	return ModemPresetName(t.lora.GetModemPreset())
+}
+
+// ChannelRef describes the channel a packet was received on
+type ChannelRef struct {
+	Name     string // Empty if the channel isn't known
+	Index    int32  // -1 if not one of the device's channels
+	Role     pb.Channel_Role
+	Hash     uint8
+	FromHash bool // Resolved from the on-air hash rather than a channel index
+	PKI      bool // A direct message encrypted with the recipient's public key
+}
+
+// String describes the channel, e.g. "LongFast (primary)" or "unknown channel hash 0x5C"
+func (r ChannelRef) String() string {
+	switch {
+	case r.PKI:
+		return "PKI direct message"
+	case r.Name == "" && r.FromHash:
+		return fmt.Sprintf("unknown channel hash 0x%02X", r.Hash)
+	case r.Name == "":
+		return fmt.Sprintf("unknown channel index %d", r.Index)
+	case r.Index < 0:
+		return fmt.Sprintf("%s (keyring)", r.Name)
+	case r.Role == pb.Channel_PRIMARY:
+		return fmt.Sprintf("%s (primary)", r.Name)
+	default:
+		return fmt.Sprintf("%s (secondary %d)", r.Name, r.Index)
+	}
+}
+
+// ShortName returns the channel name, or the hash or index if unknown
+func (r ChannelRef) ShortName() string {
+	switch {
+	case r.PKI:
+		return "PKI"
+	case r.Name != "":
+		return r.Name
+	case r.FromHash:
+		return fmt.Sprintf("0x%02X", r.Hash)
+	default:
+		return fmt.Sprintf("#%d", r.Index)
+	}
+}
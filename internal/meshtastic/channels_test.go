@@ -0,0 +1,54 @@
+package meshtastic
+
+import (
+	"testing"
+
+	"go-mesh/pb/meshtastic"
+)
+
+// Test that channels from the config dump name packets by index and by hash
+func TestResolveChannel(t *testing.T) {
+	client := newTestClient(t)
+	client.updateConfig(&Packet{DecodedData: &pb.Config{PayloadVariant: &pb.Config_Lora{
+		Lora: &pb.Config_LoRaConfig{UsePreset: true, ModemPreset: pb.Config_LoRaConfig_MEDIUM_FAST},
+	}}})
+	client.updateConfig(&Packet{DecodedData: &pb.Channel{
+		Index:    0,
+		Role:     pb.Channel_PRIMARY,
+		Settings: &pb.ChannelSettings{Psk: []byte{0x01}},
+	}})
+	client.updateConfig(&Packet{DecodedData: &pb.Channel{
+		Index:    1,
+		Role:     pb.Channel_SECONDARY,
+		Settings: &pb.ChannelSettings{Name: "Admin", Psk: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}},
+	}})
+	client.updateConfig(&Packet{DecodedData: &pb.Channel{Index: 2, Role: pb.Channel_DISABLED}})
+
+	if channels := client.GetChannels().Channels(); len(channels) != 2 || channels[0].Name != "MediumFast" {
+		t.Fatalf("Expected MediumFast and Admin channels, got %+v", channels)
+	}
+	admin, _ := client.GetChannels().ByIndex(1)
+
+	tests := []struct {
+		packet   *Packet
+		expected string
+	}{
+		{&Packet{FromMesh: true, Channel: 0}, "MediumFast (primary)"},
+		{&Packet{FromMesh: true, Channel: 1}, "Admin (secondary 1)"},
+		{&Packet{FromMesh: true, Channel: 2}, "unknown channel index 2"},
+		{&Packet{FromMesh: true, Encrypted: true, Channel: admin.Hash}, "Admin (secondary 1)"},
+		{&Packet{FromMesh: true, Encrypted: true, Channel: 0x08}, "LongFast (keyring)"},
+		{&Packet{FromMesh: true, Encrypted: true, Channel: 0x5C}, "unknown channel hash 0x5C"},
+		{&Packet{FromMesh: true, PKIEncrypted: true}, "PKI direct message"},
+	}
+	for _, test := range tests {
+		ref, ok := client.ResolveChannel(test.packet)
+		if !ok || ref.String() != test.expected {
+			t.Errorf("Channel %d (encrypted=%t): expected %q, got %q", test.packet.Channel, test.packet.Encrypted, test.expected, ref.String())
+		}
+	}
+
+	if _, ok := client.ResolveChannel(&Packet{Type: PacketTypeConfig}); ok {
+		t.Error("Expected no channel for a packet from the local device")
+	}
+}
@@ -1,1328 +1,1383 @@
-package meshtastic
-
-import (
-	"encoding/binary"
-	"encoding/json"
-	"fmt"
-	"log"
-	"math"
-	"regexp"
-	"strconv"
-	"strings"
-	"sync"
-	"time"
-)
-
-// Connection interface for abstracted connections
-type Connection interface {
-	Connect() error
-	Close() error
-	IsConnected() bool
-	GetConnectionInfo() string
-	StartPacketListener(handler func([]byte) error) error
-	SendCommand(command string) error
-}
-
-// Client represents a Meshtastic client that handles protocol communication
-type Client struct {
-	connection  Connection
-	logger      *log.Logger
-	packets     chan *Packet
-	subscribers []PacketSubscriber
-	mu          sync.RWMutex
-	stats       *Statistics
-	started     bool
-	nodeDB      *NodeDB
-}
-
-// PacketSubscriber defines the interface for packet subscribers
-type PacketSubscriber interface {
-	OnPacket(*Packet)
-}
-
-// PacketSubscriberFunc is a function adapter for PacketSubscriber
-type PacketSubscriberFunc func(*Packet)
-
-func (f PacketSubscriberFunc) OnPacket(p *Packet) {
-	f(p)
-}
-
-// nodeIDPattern matches Meshtastic node IDs in various formats
-var nodeIDPattern = regexp.MustCompile(`!([0-9a-fA-F]{8})|0x([0-9a-fA-F]{8})`)
-
-// Statistics holds packet statistics
-type Statistics struct {
-	TotalPackets     uint64                `json:"total_packets"`
-	PacketsByType    map[PacketType]uint64 `json:"packets_by_type"`
-	PacketsByChannel map[uint8]uint64      `json:"packets_by_channel"`
-	AverageRSSI      float32               `json:"average_rssi"`
-	AverageSNR       float32               `json:"average_snr"`
-	StartTime        time.Time             `json:"start_time"`
-	LastPacketTime   time.Time             `json:"last_packet_time"`
-	mu               sync.RWMutex
-}
-
-// NewClient creates a new Meshtastic client
-func NewClient(conn Connection, logger *log.Logger) (*Client, error) {
-	client := &Client{
-		connection: conn,
-		logger:     logger,
-		packets:    make(chan *Packet, 100), // Buffer for packets
-		stats: &Statistics{
-			PacketsByType:    make(map[PacketType]uint64),
-			PacketsByChannel: make(map[uint8]uint64),
-			StartTime:        time.Now(),
-		},
-		nodeDB: NewNodeDB(),
-	}
-
-	return client, nil
-}
-
-// Start begins listening for packets from the serial connection
-func (c *Client) Start() error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	if c.started {
-		return fmt.Errorf("client already started")
-	}
-
-	c.logger.Println("Starting Meshtastic client...")
-
-	// Start the packet listener
-	c.logger.Printf("Starting packet listener goroutine...")
-	go func() {
-		c.logger.Printf("Packet listener goroutine started, calling StartPacketListener...")
-		if err := c.connection.StartPacketListener(c.handleRawData); err != nil {
-			c.logger.Printf("Packet listener error: %v", err)
-		} else {
-			c.logger.Printf("Packet listener completed successfully")
-		}
-	}()
-
-	// Start the packet processor
-	go c.processPackets()
-
-	c.started = true
-	c.logger.Println("Meshtastic client started successfully")
-
-	return nil
-}
-
-// Stop stops the client
-func (c *Client) Stop() error {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	if !c.started {
-		return nil
-	}
-
-	c.logger.Println("Stopping Meshtastic client...")
-	close(c.packets)
-	c.started = false
-
-	return nil
-}
-
-// Subscribe adds a packet subscriber
-func (c *Client) Subscribe(subscriber PacketSubscriber) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-	c.subscribers = append(c.subscribers, subscriber)
-}
-
-// SubscribeFunc adds a function-based packet subscriber
-func (c *Client) SubscribeFunc(fn func(*Packet)) {
-	c.Subscribe(PacketSubscriberFunc(fn))
-}
-
-// GetStatistics returns current packet statistics
-func (c *Client) GetStatistics() *Statistics {
-	c.stats.mu.RLock()
-	defer c.stats.mu.RUnlock()
-
-	// Create a copy to avoid race conditions
-	stats := &Statistics{
-		TotalPackets:     c.stats.TotalPackets,
-		PacketsByType:    make(map[PacketType]uint64),
-		PacketsByChannel: make(map[uint8]uint64),
-		AverageRSSI:      c.stats.AverageRSSI,
-		AverageSNR:       c.stats.AverageSNR,
-		StartTime:        c.stats.StartTime,
-		LastPacketTime:   c.stats.LastPacketTime,
-	}
-
-	for k, v := range c.stats.PacketsByType {
-		stats.PacketsByType[k] = v
-	}
-	for k, v := range c.stats.PacketsByChannel {
-		stats.PacketsByChannel[k] = v
-	}
-
-	return stats
-}
-
-// SendTextMessage sends a text message to a specific node
-func (c *Client) SendTextMessage(to uint32, message string) error {
-	if !c.connection.IsConnected() {
-		return fmt.Errorf("connection not available")
-	}
-
-	// In a real implementation, this would format and send a proper Meshtastic packet
-	// For now, we'll send a simple command
-	cmd := fmt.Sprintf("--sendtext %s", message)
-	if to != 0xFFFFFFFF {
-		cmd = fmt.Sprintf("--dest !%08x %s", to, cmd)
-	}
-
-	return c.connection.SendCommand(cmd)
-}
-
-// RequestNodeInfo requests node information from a specific node
-func (c *Client) RequestNodeInfo(nodeID uint32) error {
-	if !c.connection.IsConnected() {
-		return fmt.Errorf("connection not available")
-	}
-
-	cmd := fmt.Sprintf("--dest !%08x --request-node-info", nodeID)
-	return c.connection.SendCommand(cmd)
-}
-
-// SetDebugMode enables or disables debug mode on the device
-func (c *Client) SetDebugMode(enabled bool) error {
-	if !c.connection.IsConnected() {
-		return fmt.Errorf("connection not available")
-	}
-
-	var cmd string
-	if enabled {
-		cmd = "--set debug_log_enabled true"
-	} else {
-		cmd = "--set debug_log_enabled false"
-	}
-
-	return c.connection.SendCommand(cmd)
-}
-
-// GetNodeDB returns the node database
-func (c *Client) GetNodeDB() *NodeDB {
-	return c.nodeDB
-}
-
-// GetNodeName returns the friendly name for a node ID
-func (c *Client) GetNodeName(nodeID uint32) string {
-	return c.nodeDB.GetNodeName(nodeID)
-}
-
-// GetNodeShortName returns the short name for a node ID
-func (c *Client) GetNodeShortName(nodeID uint32) string {
-	return c.nodeDB.GetNodeShortName(nodeID)
-}
-
-// handleRawData processes raw data from the connection (binary or JSON)
-func (c *Client) handleRawData(data []byte) error {
-	c.logger.Printf("Received %d bytes of raw data: %X", len(data), data[:min(len(data), 32)])
-
-	// First, try to parse as JSON (for WiFi connections with synthetic data)
-	if packet, err := c.parseJSONPacket(data); err == nil {
-		c.logger.Printf("Parsed JSON packet successfully")
-		// Send packet to processing channel
-		select {
-		case c.packets <- packet:
-			// Successfully queued
-		default:
-			c.logger.Println("Packet queue full, dropping packet")
-		}
-		return nil
-	}
-
-	// Try to parse as FromRadio protobuf message (for TCP connections)
-	if packet, err := c.parseFromRadioMessage(data); err == nil {
-		c.logger.Printf("Parsed FromRadio message successfully: Type=%s, From=%s, To=%s",
-			packet.GetTypeName(), packet.GetFromHex(), packet.GetToHex())
-		// Send packet to processing channel
-		select {
-		case c.packets <- packet:
-			// Successfully queued
-		default:
-			c.logger.Println("Packet queue full, dropping packet")
-		}
-		return nil
-	}
-
-	// Try to parse as a binary Meshtastic packet (for serial connections)
-	packet, err := ParseRawPacket(data)
-	if err != nil {
-		c.logger.Printf("Failed to parse as binary packet: %v", err)
-		// Try to handle as text data (CLI output, etc.)
-		return c.handleTextData(data)
-	}
-
-	c.logger.Printf("Parsed packet: Type=%s, From=%s, To=%s, PayloadLen=%d",
-		packet.GetTypeName(), packet.GetFromHex(), packet.GetToHex(), len(packet.Payload))
-
-	// Send packet to processing channel
-	select {
-	case c.packets <- packet:
-		// Successfully queued
-	default:
-		c.logger.Println("Packet queue full, dropping packet")
-	}
-
-	return nil
-}
-
-// handleTextData processes text-based data from the device
-func (c *Client) handleTextData(data []byte) error {
-	text := string(data)
-	c.logger.Printf("Received text data: %s", strings.TrimSpace(text))
-
-	// Skip empty or whitespace-only messages
-	trimmed := strings.TrimSpace(text)
-	if len(trimmed) == 0 {
-		return nil
-	}
-
-	// Try to parse as JSON (for WiFi API responses)
-	if strings.HasPrefix(trimmed, "{") && strings.HasSuffix(trimmed, "}") {
-		return c.handleJSONData([]byte(trimmed))
-	}
-
-	// Create a text packet for CLI output
-	packetType := PacketTypeText
-	from := uint32(0)
-	to := uint32(0xFFFFFFFF)
-
-	// Enhanced packet type detection from text content
-	lowerText := strings.ToLower(text)
-
-	// Device startup and configuration patterns
-	if strings.Contains(lowerText, "connected to") || strings.Contains(lowerText, "starting up") ||
-		strings.Contains(lowerText, "device info") || strings.Contains(lowerText, "firmware") {
-		packetType = PacketTypeAdmin
-	} else if strings.Contains(lowerText, "channel") && (strings.Contains(lowerText, "settings") || strings.Contains(lowerText, "config")) {
-		packetType = PacketTypeAdmin
-	} else if strings.Contains(lowerText, "module") && strings.Contains(lowerText, "config") {
-		packetType = PacketTypeAdmin
-	} else if strings.Contains(lowerText, "preferences") || strings.Contains(lowerText, "setting") {
-		packetType = PacketTypeAdmin
-	} else if strings.Contains(lowerText, "my info") || strings.Contains(lowerText, "node id") ||
-		strings.Contains(lowerText, "owner") || strings.Contains(text, "!!") {
-		packetType = PacketTypeNodeInfo
-		// Check if this contains node database info
-		if nodeID := c.extractNodeInfoFromText(text); nodeID != 0 {
-			// Extract and store basic node info from text
-			c.extractAndStoreNodeInfoFromText(text, nodeID)
-		}
-	} else if strings.Contains(lowerText, "rx:") || strings.Contains(lowerText, "tx:") {
-		packetType = PacketTypeRouting
-	} else if strings.Contains(lowerText, "position") || strings.Contains(lowerText, "gps") ||
-		strings.Contains(lowerText, "lat") || strings.Contains(lowerText, "lon") {
-		packetType = PacketTypePosition
-	} else if strings.Contains(lowerText, "battery") || strings.Contains(lowerText, "voltage") ||
-		strings.Contains(lowerText, "telemetry") || strings.Contains(lowerText, "sensor") {
-		packetType = PacketTypeTelemetry
-	} else if strings.Contains(lowerText, "admin") || strings.Contains(lowerText, "config") {
-		packetType = PacketTypeAdmin
-	} else if strings.Contains(lowerText, "range") && strings.Contains(lowerText, "test") {
-		packetType = PacketTypeRangeTest
-	}
-
-	// Try to extract node IDs from text
-	if matches := nodeIDPattern.FindAllString(text, -1); len(matches) >= 2 {
-		// Found potential from/to addresses
-		if id, err := parseNodeID(matches[0]); err == nil {
-			from = id
-		}
-		if id, err := parseNodeID(matches[1]); err == nil {
-			to = id
-		}
-	} else if len(matches) == 1 {
-		if id, err := parseNodeID(matches[0]); err == nil {
-			from = id
-		}
-	}
-
-	packet := &Packet{
-		ID:     0,
-		From:   from,
-		To:     to,
-		Type:   packetType,
-		RxTime: time.Now(),
-		DecodedData: NewTextData(trimmed),
-		Raw: data,
-	}
-
-	select {
-	case c.packets <- packet:
-		// Successfully queued
-	default:
-		c.logger.Println("Packet queue full, dropping text packet")
-	}
-
-	return nil
-}
-
-// handleJSONData processes JSON-formatted data from the device
-func (c *Client) handleJSONData(data []byte) error {
-	c.logger.Printf("Received JSON data: %s", string(data))
-
-	// For now, treat JSON data as text packets
-	// In a full implementation, you'd parse specific JSON packet formats
-	packet := &Packet{
-		ID:     0,
-		From:   0,
-		To:     0xFFFFFFFF,
-		Type:   PacketTypeText,
-		RxTime: time.Now(),
-		DecodedData: NewTextData(string(data)),
-		Raw: data,
-	}
-
-	select {
-	case c.packets <- packet:
-		// Successfully queued
-	default:
-		c.logger.Println("Packet queue full, dropping JSON packet")
-	}
-
-	return nil
-}
-
-// parseJSONPacket attempts to parse JSON data from WiFi synthetic packets
-func (c *Client) parseJSONPacket(data []byte) (*Packet, error) {
-	var jsonData map[string]interface{}
-	if err := json.Unmarshal(data, &jsonData); err != nil {
-		return nil, fmt.Errorf("not valid JSON: %w", err)
-	}
-
-	// Check if this looks like our synthetic packet format
-	packetType, hasType := jsonData["type"]
-	if !hasType {
-		return nil, fmt.Errorf("JSON data missing type field")
-	}
-
-	typeStr, ok := packetType.(string)
-	if !ok {
-		return nil, fmt.Errorf("type field is not a string")
-	}
-
-	switch typeStr {
-	case "device_status":
-		return c.parseDeviceStatusPacket(jsonData)
-	default:
-		return nil, fmt.Errorf("unknown JSON packet type: %s", typeStr)
-	}
-}
-
-// parseFromRadioMessage parses a FromRadio protobuf message from TCP stream
-// This implements the parsing of messages received via Python CLI --listen equivalent
-func (c *Client) parseFromRadioMessage(data []byte) (*Packet, error) {
-	if len(data) < 4 {
-		return nil, fmt.Errorf("FromRadio message too short: %d bytes", len(data))
-	}
-
-	c.logger.Printf("Parsing FromRadio message: %d bytes, preview: %X", len(data), data[:min(len(data), 8)])
-
-	// Basic protobuf field parsing for FromRadio message
-	// This is a simplified parser - in production you'd use proper protobuf libraries
-	packet := &Packet{
-		RxTime: time.Now(),
-		Raw:    data,
-	}
-
-	// Parse protobuf fields
-	offset := 0
-	for offset < len(data) {
-		if offset+1 >= len(data) {
-			break
-		}
-
-		// Read field tag and wire type
-		tag := data[offset]
-		fieldNumber := tag >> 3
-		wireType := tag & 0x07
-
-		offset++
-
-		c.logger.Printf("  Field %d, wire type %d at offset %d", fieldNumber, wireType, offset-1)
-
-		switch fieldNumber {
-		case 2: // packet field in FromRadio
-			if wireType == 2 { // Length-delimited
-				length, newOffset := c.readVarintAt(data, offset)
-				if newOffset == -1 || int(newOffset)+int(length) > len(data) {
-					return nil, fmt.Errorf("invalid packet field length")
-				}
-				packetData := data[newOffset : newOffset+int(length)]
-				c.logger.Printf("  Found packet data: %d bytes", len(packetData))
-				
-				// Parse the MeshPacket within the FromRadio
-				if err := c.parseMeshPacket(packet, packetData); err != nil {
-					c.logger.Printf("  Failed to parse MeshPacket: %v", err)
-				} else {
-					c.logger.Printf("  Successfully parsed MeshPacket")
-				}
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 3: // my_info field
-			if wireType == 2 { // Length-delimited
-				c.logger.Printf("  Found my_info field (device info)")
-				length, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 && int(newOffset)+int(length) <= len(data) {
-					myInfoData := data[newOffset : newOffset+int(length)]
-					c.logger.Printf("  MyInfo data: %d bytes", len(myInfoData))
-					// Create a synthetic packet for device info
-					packet.Type = PacketTypeNodeInfo
-					packet.From = 0 // Local device
-					packet.To = 0xFFFFFFFF
-					packet.DecodedData = &NodeInfo{
-						ID:        "LOCAL_DEVICE",
-						LongName:  "My Device Info",
-						ShortName: "MINE",
-					}
-				}
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 4: // node_info field  
-			if wireType == 2 { // Length-delimited
-				c.logger.Printf("  Found node_info field")
-				length, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 && int(newOffset)+int(length) <= len(data) {
-					nodeInfoData := data[newOffset : newOffset+int(length)]
-					c.logger.Printf("  NodeInfo data: %d bytes, hex: %X", len(nodeInfoData), nodeInfoData[:min(len(nodeInfoData), 32)])
-					
-					// Try to parse the NodeInfo protobuf data
-					if nodeInfo := c.parseNodeInfoData(nodeInfoData); nodeInfo != nil {
-						c.logger.Printf("  Successfully parsed NodeInfo: %s (%s)", nodeInfo.LongName, nodeInfo.ShortName)
-						packet.Type = PacketTypeNodeInfo
-						packet.From = 0 // Device sending node DB info
-						packet.To = 0xFFFFFFFF
-						packet.DecodedData = nodeInfo
-					} else {
-						c.logger.Printf("  Failed to parse NodeInfo data")
-						// Create a text packet with hex data for debugging
-						packet.Type = PacketTypeText
-						packet.From = 0
-						packet.To = 0xFFFFFFFF
-						packet.DecodedData = NewTextData(fmt.Sprintf("NodeInfo data: %X", nodeInfoData))
-					}
-				}
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 5: // config field
-			if wireType == 2 { // Length-delimited
-				c.logger.Printf("  Found config field")
-				length, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 && int(newOffset)+int(length) <= len(data) {
-					configData := data[newOffset : newOffset+int(length)]
-					c.logger.Printf("  Config data: %d bytes", len(configData))
-					packet.Type = PacketTypeAdmin
-					packet.From = 0
-					packet.To = 0xFFFFFFFF
-				}
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 6: // log_record field
-			if wireType == 2 { // Length-delimited
-				c.logger.Printf("  Found log_record field")
-				length, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 && int(newOffset)+int(length) <= len(data) {
-					logData := data[newOffset : newOffset+int(length)]
-					c.logger.Printf("  Log record: %d bytes", len(logData))
-					packet.Type = PacketTypeText
-					packet.From = 0
-					packet.To = 0xFFFFFFFF
-					packet.DecodedData = &TextData{
-						Text: "[LOG] Device log record",
-					}
-				}
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		default:
-			// Skip unknown fields
-			offset = c.skipField(data, offset, int(wireType))
-		}
-
-		if offset == -1 {
-			return nil, fmt.Errorf("error parsing FromRadio message")
-		}
-	}
-
-	// Set defaults if not parsed from packet
-	if packet.Type == 0 {
-		packet.Type = PacketTypeUnknown
-	}
-	if packet.To == 0 {
-		packet.To = 0xFFFFFFFF // Default to broadcast
-	}
-
-	c.logger.Printf("Parsed FromRadio: ID=%d, From=%08x, To=%08x, Type=%s", 
-		packet.ID, packet.From, packet.To, packet.GetTypeName())
-
-	return packet, nil
-}
-
-// parseMeshPacket parses a MeshPacket from within a FromRadio message
-func (c *Client) parseMeshPacket(packet *Packet, data []byte) error {
-	if len(data) < 4 {
-		return fmt.Errorf("MeshPacket too short: %d bytes", len(data))
-	}
-
-	c.logger.Printf("    Parsing MeshPacket: %d bytes, hex: %X", len(data), data[:min(len(data), 32)])
-
-	offset := 0
-	for offset < len(data) {
-		if offset >= len(data) {
-			break
-		}
-
-		tag := data[offset]
-		fieldNumber := tag >> 3
-		wireType := tag & 0x07
-		c.logger.Printf("      Tag: 0x%02X, Field: %d, WireType: %d at offset %d", tag, fieldNumber, wireType, offset)
-		offset++
-
-		switch fieldNumber {
-		case 1: // from
-			if wireType == 0 { // Varint
-				c.logger.Printf("        Parsing From field (varint) at offset %d", offset)
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					packet.From = uint32(value)
-					c.logger.Printf("        From: %08x (value: %d, new offset: %d)", packet.From, value, newOffset)
-				} else {
-					c.logger.Printf("        Failed to read varint for From field")
-				}
-				offset = newOffset
-			} else if wireType == 5 { // Fixed32
-				c.logger.Printf("        Parsing From field (fixed32) at offset %d", offset)
-				if offset+4 <= len(data) {
-					packet.From = binary.LittleEndian.Uint32(data[offset:offset+4])
-					c.logger.Printf("        From: %08x (fixed32, new offset: %d)", packet.From, offset+4)
-					offset += 4
-				} else {
-					c.logger.Printf("        Not enough data for fixed32 From field")
-					offset = len(data) // Skip to end
-				}
-			} else {
-				c.logger.Printf("        Skipping From field with wire type %d", wireType)
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 2: // to  
-			if wireType == 0 { // Varint
-				c.logger.Printf("        Parsing To field (varint) at offset %d", offset)
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					packet.To = uint32(value)
-					c.logger.Printf("        To: %08x (value: %d, new offset: %d)", packet.To, value, newOffset)
-				} else {
-					c.logger.Printf("        Failed to read varint for To field")
-				}
-				offset = newOffset
-			} else if wireType == 5 { // Fixed32
-				c.logger.Printf("        Parsing To field (fixed32) at offset %d", offset)
-				if offset+4 <= len(data) {
-					packet.To = binary.LittleEndian.Uint32(data[offset:offset+4])
-					c.logger.Printf("        To: %08x (fixed32, new offset: %d)", packet.To, offset+4)
-					offset += 4
-				} else {
-					c.logger.Printf("        Not enough data for fixed32 To field")
-					offset = len(data) // Skip to end
-				}
-			} else {
-				c.logger.Printf("        Skipping To field with wire type %d", wireType)
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 3: // channel
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					packet.Channel = uint8(value)
-					c.logger.Printf("      Channel: %d", packet.Channel)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 6: // id
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					packet.ID = uint32(value)
-					c.logger.Printf("      ID: %d", packet.ID)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 7: // rx_time
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					packet.RxTime = time.Unix(int64(value), 0)
-					c.logger.Printf("        RxTime: %v (value: %d)", packet.RxTime, value)
-				}
-				offset = newOffset
-			} else if wireType == 1 { // Fixed64
-				if offset+8 <= len(data) {
-					timestamp := binary.LittleEndian.Uint64(data[offset:offset+8])
-					packet.RxTime = time.Unix(int64(timestamp), 0)
-					c.logger.Printf("        RxTime: %v (fixed64)", packet.RxTime)
-				}
-				offset += 8
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 8: // rx_snr
-			if wireType == 5 { // Fixed32 (float)
-				if offset+4 <= len(data) {
-					bits := binary.LittleEndian.Uint32(data[offset:offset+4])
-					packet.RxSNR = math.Float32frombits(bits)
-					c.logger.Printf("        RxSNR: %.2f", packet.RxSNR)
-					offset += 4
-				} else {
-					c.logger.Printf("        Not enough data for float RxSNR field")
-					offset = len(data)
-				}
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 9: // hop_limit
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					packet.HopLimit = uint8(value)
-					c.logger.Printf("        HopLimit: %d", packet.HopLimit)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 10: // want_ack
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					packet.WantAck = value != 0
-					c.logger.Printf("        WantAck: %t", packet.WantAck)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 11: // priority
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					packet.Priority = uint8(value)
-					c.logger.Printf("        Priority: %d", packet.Priority)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 12: // rx_rssi
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					packet.RxRSSI = int32(value)
-					c.logger.Printf("        RxRSSI: %d dBm", packet.RxRSSI)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 14: // via_mqtt
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					viaMqtt := value != 0
-					c.logger.Printf("        ViaMqtt: %t", viaMqtt)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 15: // hop_start
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					hopStart := uint8(value)
-					c.logger.Printf("        HopStart: %d", hopStart)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 16: // public_key
-			if wireType == 2 { // Length-delimited
-				length, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 && int(newOffset)+int(length) <= len(data) {
-					publicKey := data[newOffset : newOffset+int(length)]
-					c.logger.Printf("        PublicKey: %d bytes", len(publicKey))
-				}
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 17: // pki_encrypted
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					pkiEncrypted := value != 0
-					c.logger.Printf("        PkiEncrypted: %t", pkiEncrypted)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 4: // decoded field - contains Data protobuf message
-			if wireType == 2 { // Length-delimited
-				c.logger.Printf("        Parsing decoded field at offset %d", offset)
-				length, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 && int(newOffset)+int(length) <= len(data) {
-					dataMsg := data[newOffset : newOffset+int(length)]
-					c.logger.Printf("        Data message: %d bytes: %X", len(dataMsg), dataMsg[:min(len(dataMsg), 32)])
-					// Parse the Data protobuf message
-					if err := c.parseDataMessage(packet, dataMsg); err != nil {
-						c.logger.Printf("        Failed to parse Data message: %v", err)
-						// Fallback to old method
-						packet.Payload = dataMsg
-						packet.Type = inferPacketType(dataMsg)
-						packet.DecodedData = decodePayload(packet.Type, dataMsg)
-					} else {
-						c.logger.Printf("        Successfully parsed Data message, type: %s", packet.GetTypeName())
-					}
-				}
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		default:
-			// Skip unknown fields
-			offset = c.skipField(data, offset, int(wireType))
-		}
-
-		if offset == -1 {
-			return fmt.Errorf("error parsing MeshPacket")
-		}
-	}
-
-	return nil
-}
-
-// parseDataMessage parses a Data protobuf message and extracts portnum and payload
-func (c *Client) parseDataMessage(packet *Packet, data []byte) error {
-	if len(data) < 2 {
-		return fmt.Errorf("Data message too short: %d bytes", len(data))
-	}
-
-	c.logger.Printf("      Parsing Data message: %d bytes", len(data))
-
-	var portnum uint32
-	var payload []byte
-	offset := 0
-
-	for offset < len(data) {
-		if offset >= len(data) {
-			break
-		}
-
-		tag := data[offset]
-		fieldNumber := tag >> 3
-		wireType := tag & 0x07
-		offset++
-
-		switch fieldNumber {
-		case 1: // portnum
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					portnum = uint32(value)
-					c.logger.Printf("        PortNum: %d", portnum)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 2: // payload
-			if wireType == 2 { // Length-delimited
-				length, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 && int(newOffset)+int(length) <= len(data) {
-					payload = data[newOffset : newOffset+int(length)]
-					c.logger.Printf("        Payload: %d bytes: %X", len(payload), payload[:min(len(payload), 32)])
-				}
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		default:
-			// Skip unknown fields
-			offset = c.skipField(data, offset, int(wireType))
-		}
-
-		if offset == -1 {
-			return fmt.Errorf("error parsing Data message")
-		}
-	}
-
-	// Map portnum to packet type
-	if packetType, exists := PortNumToPacketType[portnum]; exists {
-		packet.Type = packetType
-		c.logger.Printf("        Mapped portnum %d to type %s", portnum, packet.GetTypeName())
-	} else {
-		packet.Type = PacketTypeUnknown
-		c.logger.Printf("        Unknown portnum %d, using UNKNOWN type", portnum)
-	}
-
-	// Set the actual payload from the Data message
-	packet.Payload = payload
-	packet.DecodedData = decodePayload(packet.Type, payload)
-
-	return nil
-}
-
-// Helper methods for protobuf parsing
-func (c *Client) readVarintAt(data []byte, offset int) (uint64, int) {
-	var result uint64
-	var shift uint
-	current := offset
-	
-	for current < len(data) {
-		b := data[current]
-		current++
-		
-		result |= uint64(b&0x7F) << shift
-		
-		if b&0x80 == 0 {
-			return result, current
-		}
-		
-		shift += 7
-		if shift >= 64 {
-			return 0, -1
-		}
-	}
-	
-	return 0, -1
-}
-
-func (c *Client) skipField(data []byte, offset int, wireType int) int {
-	switch wireType {
-	case 0: // Varint
-		_, newOffset := c.readVarintAt(data, offset)
-		return newOffset
-	case 1: // Fixed64
-		return offset + 8
-	case 2: // Length-delimited
-		length, newOffset := c.readVarintAt(data, offset)
-		if newOffset == -1 {
-			return -1
-		}
-		return newOffset + int(length)
-	case 5: // Fixed32
-		return offset + 4
-	default:
-		return -1
-	}
-}
-
-// parseDeviceStatusPacket creates a packet from device status JSON
-func (c *Client) parseDeviceStatusPacket(jsonData map[string]interface{}) (*Packet, error) {
-	// Extract timestamp
-	timestamp, _ := jsonData["timestamp"].(float64)
-	rxTime := time.Unix(int64(timestamp), 0)
-
-	// Extract device info
-	deviceInfo, hasDeviceInfo := jsonData["device_info"].(map[string]interface{})
-	if !hasDeviceInfo {
-		return nil, fmt.Errorf("device_status packet missing device_info")
-	}
-
-	// Create a telemetry-like packet from the device status
-	packet := &Packet{
-		ID:       0,
-		From:     0x12345678, // Use a synthetic node ID
-		To:       0xFFFFFFFF, // Broadcast
-		Type:     PacketTypeTelemetry,
-		Channel:  0,
-		HopCount: 0,
-		HopLimit: 3,
-		RxTime:   rxTime,
-		Raw:      []byte(fmt.Sprintf("Device Status: %v", deviceInfo)),
-	}
-
-	// Extract telemetry data from device info
-	telemetry := &TelemetryData{
-		Time: uint32(timestamp),
-		DeviceMetrics: &DeviceMetrics{},
-	}
-
-	// Extract power information if available
-	if power, hasPower := deviceInfo["power"].(map[string]interface{}); hasPower {
-		if battPct, hasBatt := power["battery_percent"]; hasBatt {
-			if battFloat, ok := battPct.(float64); ok {
-				telemetry.DeviceMetrics.BatteryLevel = uint32(battFloat)
-			}
-		}
-		if voltage, hasVolt := power["battery_voltage_mv"]; hasVolt {
-			if voltFloat, ok := voltage.(float64); ok {
-				telemetry.DeviceMetrics.Voltage = float32(voltFloat) / 1000.0 // Convert mV to V
-			}
-		}
-	}
-
-	// Extract airtime information if available
-	if airtime, hasAirtime := deviceInfo["airtime"].(map[string]interface{}); hasAirtime {
-		if chanUtil, hasChanUtil := airtime["channel_utilization"]; hasChanUtil {
-			if utilFloat, ok := chanUtil.(float64); ok {
-				telemetry.DeviceMetrics.ChannelUtilization = float32(utilFloat)
-			}
-		}
-		if txUtil, hasTxUtil := airtime["utilization_tx"]; hasTxUtil {
-			if txFloat, ok := txUtil.(float64); ok {
-				telemetry.DeviceMetrics.AirUtilTx = float32(txFloat)
-			}
-		}
-	}
-
-	// Extract WiFi signal information if available
-	if wifi, hasWifi := deviceInfo["wifi"].(map[string]interface{}); hasWifi {
-		if rssi, hasRssi := wifi["rssi"]; hasRssi {
-			if rssiFloat, ok := rssi.(float64); ok {
-				packet.RxRSSI = int32(rssiFloat)
-			}
-		}
-	}
-
-	packet.DecodedData = telemetry
-	return packet, nil
-}
-
-// processPackets processes packets from the queue
-func (c *Client) processPackets() {
-	for packet := range c.packets {
-		// Update statistics
-		c.updateStatistics(packet)
-
-		// Update NodeDB with packet information
-		c.updateNodeDB(packet)
-
-		// Notify subscribers
-		c.mu.RLock()
-		for _, subscriber := range c.subscribers {
-			go subscriber.OnPacket(packet) // Process in goroutine to avoid blocking
-		}
-		c.mu.RUnlock()
-
-		c.logger.Printf("Processed packet: From=%s, To=%s, Type=%s",
-			packet.GetFromHex(), packet.GetToHex(), packet.GetTypeName())
-	}
-}
-
-// updateStatistics updates packet statistics
-func (c *Client) updateStatistics(packet *Packet) {
-	c.stats.mu.Lock()
-	defer c.stats.mu.Unlock()
-
-	c.stats.TotalPackets++
-	c.stats.PacketsByType[packet.Type]++
-	c.stats.PacketsByChannel[packet.Channel]++
-	c.stats.LastPacketTime = packet.RxTime
-
-	// Update average RSSI and SNR
-	if packet.RxRSSI != 0 {
-		if c.stats.AverageRSSI == 0 {
-			c.stats.AverageRSSI = float32(packet.RxRSSI)
-		} else {
-			c.stats.AverageRSSI = (c.stats.AverageRSSI + float32(packet.RxRSSI)) / 2
-		}
-	}
-
-	if packet.RxSNR != 0 {
-		if c.stats.AverageSNR == 0 {
-			c.stats.AverageSNR = packet.RxSNR
-		} else {
-			c.stats.AverageSNR = (c.stats.AverageSNR + packet.RxSNR) / 2
-		}
-	}
-}
-
-// updateNodeDB updates the node database with information from the packet
-func (c *Client) updateNodeDB(packet *Packet) {
-	// Always track when we heard from this node (could extend NodeDB later)
-	if packet.From != 0 {
-		c.logger.Printf("Received packet from node %08x", packet.From)
-	}
-
-	// Handle specific packet types that contain node information
-	switch packet.Type {
-	case PacketTypeNodeInfo:
-		if nodeInfo, ok := packet.DecodedData.(*NodeInfo); ok {
-			c.logger.Printf("Updating NodeDB with node info from node %08x: %s (%s)", packet.From, nodeInfo.LongName, nodeInfo.ShortName)
-			
-			// Extract node ID from the packet itself, use From field if nodeInfo.ID is not available 
-			nodeID := packet.From
-			if nodeInfo.ID != "" {
-				if parsed, err := parseNodeID(nodeInfo.ID); err == nil {
-					nodeID = parsed
-				}
-			}
-			
-			// Store node data in simplified NodeDB
-			c.nodeDB.AddOrUpdateUserInfo(nodeID, nodeInfo.ID, nodeInfo.LongName, nodeInfo.ShortName)
-		}
-
-	case PacketTypePosition:
-		if _, ok := packet.DecodedData.(*PositionData); ok {
-			c.logger.Printf("Updating NodeDB with position data from node %08x", packet.From)
-		}
-
-	case PacketTypeTelemetry:
-		if _, ok := packet.DecodedData.(*TelemetryData); ok {
-			c.logger.Printf("Updating NodeDB with telemetry data from node %08x", packet.From)
-		}
-	}
-}
-
-// IsConnected returns true if the client is connected and started
-func (c *Client) IsConnected() bool {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-	return c.started && c.connection.IsConnected()
-}
-
-// GetConnectionInfo returns connection information
-func (c *Client) GetConnectionInfo() string {
-	if !c.IsConnected() {
-		return "Disconnected"
-	}
-
-	return c.connection.GetConnectionInfo()
-}
-
-// min returns the minimum of two integers
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
-// parseNodeID extracts node ID from string containing !12345678 or 0x12345678
-func parseNodeID(nodeStr string) (uint32, error) {
-	// Remove ! or 0x prefix
-	nodeStr = strings.TrimPrefix(nodeStr, "!")
-	nodeStr = strings.TrimPrefix(nodeStr, "0x")
-	nodeStr = strings.TrimPrefix(nodeStr, "0X")
-
-	// Parse as hex
-	id, err := strconv.ParseUint(nodeStr, 16, 32)
-	if err != nil {
-		return 0, err
-	}
-	return uint32(id), nil
-}
-
-// extractNodeInfoFromText attempts to extract a node ID from text output
-func (c *Client) extractNodeInfoFromText(text string) uint32 {
-	// Look for node ID patterns like "!12345678" or "Node: 0x12345678"
-	patterns := []string{
-		`!([0-9a-fA-F]{8})`,
-		`0x([0-9a-fA-F]{8})`,
-		`node.*?([0-9a-fA-F]{8})`,
-		`id.*?([0-9a-fA-F]{8})`,
-	}
-	
-	for _, pattern := range patterns {
-		re := regexp.MustCompile(`(?i)` + pattern)
-		matches := re.FindStringSubmatch(text)
-		if len(matches) > 1 {
-			if id, err := parseNodeID(matches[1]); err == nil {
-				return id
-			}
-		}
-	}
-	
-	return 0
-}
-
-// extractAndStoreNodeInfoFromText extracts and stores node information from text output
-func (c *Client) extractAndStoreNodeInfoFromText(text string, nodeID uint32) {
-	// Try to extract name information from various text formats
-	longName := ""
-	shortName := ""
-	id := fmt.Sprintf("!%08x", nodeID)
-	
-	// Look for name patterns in text
-	// Example: "Owner: John Doe (JD)" or "User: Alice Smith" or "Name: Bob"
-	namePatterns := []struct {
-		pattern string
-		longIdx int
-		shortIdx int
-	}{
-		{`(?i)owner:?\s*([^\(\n]+)\s*\(([^\)]+)\)`, 1, 2}, // "Owner: John Doe (JD)"
-		{`(?i)user:?\s*([^\(\n]+)\s*\(([^\)]+)\)`, 1, 2},  // "User: Alice Smith (AS)"
-		{`(?i)name:?\s*([^\(\n]+)\s*\(([^\)]+)\)`, 1, 2},  // "Name: Bob Jones (BJ)"
-		{`(?i)owner:?\s*([^\n]+)`, 1, 0},                   // "Owner: John Doe"
-		{`(?i)user:?\s*([^\n]+)`, 1, 0},                    // "User: Alice Smith"
-		{`(?i)name:?\s*([^\n]+)`, 1, 0},                    // "Name: Bob"
-	}
-	
-	for _, np := range namePatterns {
-		re := regexp.MustCompile(np.pattern)
-		matches := re.FindStringSubmatch(text)
-		if len(matches) > np.longIdx {
-			longName = strings.TrimSpace(matches[np.longIdx])
-			if np.shortIdx > 0 && len(matches) > np.shortIdx {
-				shortName = strings.TrimSpace(matches[np.shortIdx])
-			}
-			break
-		}
-	}
-	
-	// If we extracted any name info, store it
-	if longName != "" || shortName != "" {
-		c.logger.Printf("Extracted node info from text: %08x -> '%s' (%s)", nodeID, longName, shortName)
-		c.nodeDB.AddOrUpdateUserInfo(nodeID, id, longName, shortName)
-	}
-}
-
-// parseNodeInfoData parses NodeInfo protobuf data from FromRadio messages
-func (c *Client) parseNodeInfoData(data []byte) *NodeInfo {
-	if len(data) < 4 {
-		c.logger.Printf("NodeInfo data too short: %d bytes", len(data))
-		return nil
-	}
-
-	c.logger.Printf("Parsing NodeInfo protobuf: %d bytes", len(data))
-	nodeInfo := &NodeInfo{}
-	offset := 0
-
-	for offset < len(data) {
-		if offset >= len(data) {
-			break
-		}
-
-		tag := data[offset]
-		fieldNumber := tag >> 3
-		wireType := tag & 0x07
-		c.logger.Printf("  NodeInfo field %d, wireType %d at offset %d", fieldNumber, wireType, offset)
-		offset++
-
-		switch fieldNumber {
-		case 1: // num (node number)
-			if wireType == 0 { // Varint
-				value, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 {
-					nodeID := uint32(value)
-					c.logger.Printf("    Node ID: %08x", nodeID)
-					nodeInfo.ID = fmt.Sprintf("!%08x", nodeID)
-				}
-				offset = newOffset
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 2: // user field (User protobuf)
-			if wireType == 2 { // Length-delimited
-				length, newOffset := c.readVarintAt(data, offset)
-				if newOffset != -1 && int(newOffset)+int(length) <= len(data) {
-					userdata := data[newOffset : newOffset+int(length)]
-					c.logger.Printf("    User data: %d bytes", len(userdata))
-					
-					// Parse the User protobuf
-					if user := parseUserMessage(userdata); user != nil {
-						nodeInfo.LongName = user.LongName
-						nodeInfo.ShortName = user.ShortName
-						if nodeInfo.ID == "" && user.ID != "" {
-							nodeInfo.ID = user.ID
-						}
-						nodeInfo.HwModel = user.HwModel
-						nodeInfo.Role = user.Role
-						nodeInfo.MacAddr = user.MacAddr
-						c.logger.Printf("    Parsed user: %s (%s)", user.LongName, user.ShortName)
-					}
-				}
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 3: // position field
-			if wireType == 2 { // Length-delimited
-				c.logger.Printf("    Found position data (skipping for now)")
-				length, newOffset := c.readVarintAt(data, offset)
-				offset = newOffset + int(length)
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 4: // snr field
-			if wireType == 5 { // Fixed32 (float)
-				c.logger.Printf("    Found SNR data (skipping)")
-				offset += 4
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		case 5: // last_heard field
-			if wireType == 5 { // Fixed32
-				c.logger.Printf("    Found last_heard data (skipping)")
-				offset += 4
-			} else {
-				offset = c.skipField(data, offset, int(wireType))
-			}
-
-		default:
-			c.logger.Printf("    Skipping unknown NodeInfo field %d", fieldNumber)
-			offset = c.skipField(data, offset, int(wireType))
-		}
-
-		if offset == -1 {
-			c.logger.Printf("  Error parsing NodeInfo at offset")
-			break
-		}
-	}
-
-	// Only return if we got some useful data
-	if nodeInfo.ID != "" || nodeInfo.LongName != "" || nodeInfo.ShortName != "" {
-		return nodeInfo
-	}
-
-	return nil
-}
+package meshtastic
+
+import (
+	"crypto/ecdh"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// Connection interface for abstracted connections
+type Connection interface {
+	Connect() error
+	Close() error
+	IsConnected() bool
+	GetConnectionInfo() string
+	StartPacketListener(handler func([]byte) error) error
+	SendCommand(command string) error
+}
+
+// Clock is implemented by connections that supply their own receive times,
+// such as a replayed capture
+type Clock interface {
+	Now() time.Time
+}
+
+// ReplayProgress describes how far a replay has got
+type ReplayProgress struct {
+	Delivered int       // Records delivered so far
+	Total     int       // Records in the capture
+	Time      time.Time // Receive time of the last delivered record
+	Speed     float64   // Playback speed, 0 for as fast as possible
+	Paused    bool
+	Finished  bool
+}
+
+// ReplayControl is implemented by connections that play back a recording
+type ReplayControl interface {
+	Pause()
+	Resume()
+	Step()
+	Progress() ReplayProgress
+}
+
+// Client represents a Meshtastic client that handles protocol communication
+type Client struct {
+	connection  Connection
+	logger      *log.Logger
+	packets     chan *Packet
+	subscribers []PacketSubscriber
+	mu          sync.RWMutex
+	stats       *Statistics
+	started     bool
+	nodeDB      *NodeDB
+	myNodeNum   uint32
+	deliveries  *DeliveryTracker
+	recorder    FrameRecorder
+	clock       func() time.Time
+	keyring     *Keyring
+	privateKey  *ecdh.PrivateKey
+	channels    *ChannelTable
+	config      *ConfigStore
+	handshake   *handshake
+	deviceLog   *DeviceLog
+	topology    *Topology
+	waypoints   *WaypointStore
+	tracks      *PositionHistory
+	linkEvent   *ConnectionEvent
+	reconnects  int
+
+	// Session passkeys from remote nodes' admin replies, keyed by node number
+	sessionPasskeys map[uint32]sessionPasskey
+
+	// Waiters for packets carrying a request_id, keyed by the request's packet ID
+	responseMu sync.Mutex
+	responses  map[uint32]chan *Packet
+}
+
+// FrameRecorder receives everything read from the connection before it is parsed
+type FrameRecorder interface {
+	RecordFrame(data []byte, rxTime time.Time) error
+	RecordLog(line string, rxTime time.Time) error
+}
+
+// PacketSubscriber defines the interface for packet subscribers
+type PacketSubscriber interface {
+	OnPacket(*Packet)
+}
+
+// PacketSubscriberFunc is a function adapter for PacketSubscriber
+type PacketSubscriberFunc func(*Packet)
+
+func (f PacketSubscriberFunc) OnPacket(p *Packet) {
+	f(p)
+}
+
+// nodeIDPattern matches Meshtastic node IDs in various formats
+var nodeIDPattern = regexp.MustCompile(`!([0-9a-fA-F]{8})|0x([0-9a-fA-F]{8})`)
+
+// Statistics holds packet statistics
+type Statistics struct {
+	TotalPackets     uint64                `json:"total_packets"`
+	PacketsByType    map[PacketType]uint64 `json:"packets_by_type"`
+	PacketsByChannel map[uint8]uint64      `json:"packets_by_channel"`
+	AverageRSSI      float32               `json:"average_rssi"`
+	AverageSNR       float32               `json:"average_snr"`
+	StartTime        time.Time             `json:"start_time"`
+	LastPacketTime   time.Time             `json:"last_packet_time"`
+	mu               sync.RWMutex
+}
+
+// NewClient creates a new Meshtastic client
+func NewClient(conn Connection, logger *log.Logger) (*Client, error) {
+	clock := time.Now
+	if c, ok := conn.(Clock); ok {
+		clock = c.Now
+	}
+
+	client := &Client{
+		connection: conn,
+		logger:     logger,
+		packets:    make(chan *Packet, 100), // Buffer for packets
+		stats: &Statistics{
+			PacketsByType:    make(map[PacketType]uint64),
+			PacketsByChannel: make(map[uint8]uint64),
+			StartTime:        clock(),
+		},
+		nodeDB:     NewNodeDB(),
+		deliveries: NewDeliveryTracker(),
+		responses:  make(map[uint32]chan *Packet),
+		clock:      clock,
+		keyring:    NewKeyring(),
+		channels:   NewChannelTable(),
+		config:     NewConfigStore(),
+		handshake:  newHandshake(),
+		deviceLog:  NewDeviceLog(DefaultDeviceLogSize),
+		topology:   NewTopology(),
+		waypoints:  NewWaypointStore(),
+		tracks:     NewPositionHistory(DefaultTrackLength),
+
+		sessionPasskeys: make(map[uint32]sessionPasskey),
+	}
+	client.nodeDB.SetClock(clock)
+
+	return client, nil
+}
+
+// Start begins listening for packets from the serial connection
+func (c *Client) Start() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.started {
+		return fmt.Errorf("client already started")
+	}
+
+	c.logger.Println("Starting Meshtastic client...")
+
+	// Debug output interleaved with the stream is shown as log records
+	if source, ok := c.connection.(DebugLogSource); ok {
+		source.SetDebugLogHandler(c.handleDebugLog)
+	}
+
+	// Connections that reconnect by themselves need the handshake sent again
+	if source, ok := c.connection.(ConnectionEventSource); ok {
+		source.SetConnectionEventHandler(c.handleConnectionEvent)
+	}
+
+	// Start the packet listener
+	c.logger.Printf("Starting packet listener goroutine...")
+	go func() {
+		c.logger.Printf("Packet listener goroutine started, calling StartPacketListener...")
+		if err := c.connection.StartPacketListener(c.handleRawData); err != nil {
+			c.logger.Printf("Packet listener error: %v", err)
+		} else {
+			c.logger.Printf("Packet listener completed successfully")
+		}
+	}()
+
+	// Start the packet processor
+	go c.processPackets()
+
+	// Ask for the config dump; sends are held back until it completes
+	c.requestConfig()
+
+	c.started = true
+	c.logger.Println("Meshtastic client started successfully")
+
+	return nil
+}
+
+// SetRecorder records raw frames and debug log lines to rec, nil to stop recording
+func (c *Client) SetRecorder(rec FrameRecorder) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.recorder = rec
+}
+
+// SetKeyring replaces the channel keys used to decrypt packets the device passes on encrypted
+func (c *Client) SetKeyring(keyring *Keyring) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keyring = keyring
+}
+
+// GetKeyring returns the channel keys used for decryption
+func (c *Client) GetKeyring() *Keyring {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.keyring
+}
+
+// SetPrivateKey sets the Curve25519 private key used to decrypt PKI direct messages
+func (c *Client) SetPrivateKey(key *ecdh.PrivateKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.privateKey = key
+}
+
+// getPrivateKey returns the private key for PKI decryption, or nil
+func (c *Client) getPrivateKey() *ecdh.PrivateKey {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.privateKey
+}
+
+// getRecorder returns the current recorder, or nil
+func (c *Client) getRecorder() FrameRecorder {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.recorder
+}
+
+// Stop stops the client
+func (c *Client) Stop() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.started {
+		return nil
+	}
+
+	c.logger.Println("Stopping Meshtastic client...")
+	close(c.packets)
+	c.started = false
+
+	return nil
+}
+
+// Subscribe adds a packet subscriber
+func (c *Client) Subscribe(subscriber PacketSubscriber) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscribers = append(c.subscribers, subscriber)
+}
+
+// SubscribeFunc adds a function-based packet subscriber
+func (c *Client) SubscribeFunc(fn func(*Packet)) {
+	c.Subscribe(PacketSubscriberFunc(fn))
+}
+
+// GetStatistics returns current packet statistics
+func (c *Client) GetStatistics() *Statistics {
+	c.stats.mu.RLock()
+	defer c.stats.mu.RUnlock()
+
+	// Create a copy to avoid race conditions
+	stats := &Statistics{
+		TotalPackets:     c.stats.TotalPackets,
+		PacketsByType:    make(map[PacketType]uint64),
+		PacketsByChannel: make(map[uint8]uint64),
+		AverageRSSI:      c.stats.AverageRSSI,
+		AverageSNR:       c.stats.AverageSNR,
+		StartTime:        c.stats.StartTime,
+		LastPacketTime:   c.stats.LastPacketTime,
+	}
+
+	for k, v := range c.stats.PacketsByType {
+		stats.PacketsByType[k] = v
+	}
+	for k, v := range c.stats.PacketsByChannel {
+		stats.PacketsByChannel[k] = v
+	}
+
+	return stats
+}
+
+// SendTextMessage sends a text message to a specific node or to BroadcastAddr
+func (c *Client) SendTextMessage(to uint32, message string) error {
+	if !c.connection.IsConnected() {
+		return fmt.Errorf("connection not available")
+	}
+
+	if _, ok := c.connection.(PacketSender); !ok {
+		// Connections without a ToRadio stream only understand CLI style commands
+		cmd := fmt.Sprintf("--sendtext %s", message)
+		if to != BroadcastAddr {
+			cmd = fmt.Sprintf("--dest !%08x %s", to, cmd)
+		}
+		return c.connection.SendCommand(cmd)
+	}
+
+	opts := DefaultSendOptions()
+	opts.To = to
+	opts.WantAck = to != BroadcastAddr
+	_, err := c.SendData(pb.PortNum_TEXT_MESSAGE_APP, []byte(message), opts)
+	return err
+}
+
+// RequestNodeInfo requests node information from a specific node
+func (c *Client) RequestNodeInfo(nodeID uint32) error {
+	if !c.connection.IsConnected() {
+		return fmt.Errorf("connection not available")
+	}
+
+	if _, ok := c.connection.(PacketSender); !ok {
+		cmd := fmt.Sprintf("--dest !%08x --request-node-info", nodeID)
+		return c.connection.SendCommand(cmd)
+	}
+
+	// The request carries our own User, which the remote node stores before replying
+	user := c.getLocalUser()
+	if user == nil {
+		return fmt.Errorf("local node info not received from device yet")
+	}
+	payload, err := proto.Marshal(user)
+	if err != nil {
+		return fmt.Errorf("failed to marshal User: %w", err)
+	}
+
+	opts := DefaultSendOptions()
+	opts.To = nodeID
+	opts.WantResponse = true
+	_, err = c.SendData(pb.PortNum_NODEINFO_APP, payload, opts)
+	return err
+}
+
+// RequestPosition requests the current position of a specific node
+func (c *Client) RequestPosition(nodeID uint32) error {
+	// An empty Position with want_response set asks for the node's position
+	opts := DefaultSendOptions()
+	opts.To = nodeID
+	opts.WantResponse = true
+	_, err := c.SendData(pb.PortNum_POSITION_APP, nil, opts)
+	return err
+}
+
+// SendData sends an arbitrary portnum payload and returns the ID of the sent packet.
+// Packets sent with want_ack are tracked until the mesh acks or naks them.
+func (c *Client) SendData(portnum pb.PortNum, payload []byte, opts SendOptions) (uint32, error) {
+	if err := c.WaitForConfig(DefaultConfigTimeout); err != nil {
+		return 0, err
+	}
+	return c.sendData(portnum, payload, opts)
+}
+
+// sendData sends a packet without waiting for the config dump
+func (c *Client) sendData(portnum pb.PortNum, payload []byte, opts SendOptions) (uint32, error) {
+	sender, err := c.getPacketSender()
+	if err != nil {
+		return 0, err
+	}
+	packetID, err := sender.SendData(portnum, payload, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	if opts.WantAck {
+		c.deliveries.Track(packetID, opts.To, portnum)
+	}
+	c.publishSentPacket(packetID, portnum, payload, opts)
+	return packetID, nil
+}
+
+// sendAndAwaitResponses sends a request and returns a channel receiving every
+// packet whose request_id matches it. The waiter is registered before the
+// device can answer, so even an immediate local nak is seen. Call cancel when done.
+func (c *Client) sendAndAwaitResponses(portnum pb.PortNum, payload []byte, opts SendOptions) (<-chan *Packet, func(), error) {
+	// Wait for the config dump before taking responseMu, which the packet
+	// pipeline needs to get through the dump
+	if err := c.WaitForConfig(DefaultConfigTimeout); err != nil {
+		return nil, nil, err
+	}
+
+	c.responseMu.Lock()
+	packetID, err := c.sendData(portnum, payload, opts)
+	if err != nil {
+		c.responseMu.Unlock()
+		return nil, nil, err
+	}
+	responses := make(chan *Packet, 8)
+	c.responses[packetID] = responses
+	c.responseMu.Unlock()
+
+	cancel := func() {
+		c.responseMu.Lock()
+		delete(c.responses, packetID)
+		c.responseMu.Unlock()
+	}
+	return responses, cancel, nil
+}
+
+// dispatchResponse passes a packet to whoever is waiting for its request_id
+func (c *Client) dispatchResponse(packet *Packet) {
+	if packet.RequestID == 0 {
+		return
+	}
+
+	c.responseMu.Lock()
+	defer c.responseMu.Unlock()
+	if responses, waiting := c.responses[packet.RequestID]; waiting {
+		select {
+		case responses <- packet:
+		default:
+			c.logger.Printf("Response queue full for request %08x, dropping packet", packet.RequestID)
+		}
+	}
+}
+
+// GetDelivery returns the delivery state of a packet sent with want_ack
+func (c *Client) GetDelivery(packetID uint32) (Delivery, bool) {
+	return c.deliveries.Get(packetID)
+}
+
+// SubscribeDeliveries registers a subscriber for delivery state changes
+func (c *Client) SubscribeDeliveries(subscriber DeliverySubscriber) {
+	c.deliveries.Subscribe(subscriber)
+}
+
+// SubscribeDeliveryFunc registers a function for delivery state changes
+func (c *Client) SubscribeDeliveryFunc(fn func(Delivery)) {
+	c.SubscribeDeliveries(DeliverySubscriberFunc(fn))
+}
+
+// publishSentPacket shows an outgoing packet to subscribers alongside received ones
+func (c *Client) publishSentPacket(packetID uint32, portnum pb.PortNum, payload []byte, opts SendOptions) {
+	packetType, exists := PortNumToPacketType[uint32(portnum)]
+	if !exists {
+		packetType = PacketTypeUnknown
+	}
+
+	packet := &Packet{
+		ID:          packetID,
+		From:        c.GetMyNodeNum(),
+		To:          opts.To,
+		Type:        packetType,
+		Channel:     uint8(opts.Channel),
+		HopLimit:    uint8(opts.HopLimit),
+		WantAck:     opts.WantAck,
+		RxTime:      c.Now(),
+		Payload:     payload,
+		DecodedData: decodePayload(packetType, payload),
+		Sent:        true,
+	}
+	c.notifySubscribers(packet)
+}
+
+// Now returns the current time, which is the capture's time when replaying
+func (c *Client) Now() time.Time {
+	return c.clock()
+}
+
+// GetReplayControl returns the playback controls if the connection is a replay
+func (c *Client) GetReplayControl() (ReplayControl, bool) {
+	control, ok := c.connection.(ReplayControl)
+	return control, ok
+}
+
+// getPacketSender returns the connection as a PacketSender if it supports ToRadio frames
+func (c *Client) getPacketSender() (PacketSender, error) {
+	if !c.connection.IsConnected() {
+		return nil, fmt.Errorf("connection not available")
+	}
+	sender, ok := c.connection.(PacketSender)
+	if !ok {
+		return nil, fmt.Errorf("connection does not support sending packets")
+	}
+	return sender, nil
+}
+
+// getLocalUser builds a User message for our own node from the NodeDB
+func (c *Client) getLocalUser() *pb.User {
+	myNodeNum := c.GetMyNodeNum()
+	if myNodeNum == 0 {
+		return nil
+	}
+
+	user := &pb.User{Id: fmt.Sprintf("!%08x", myNodeNum)}
+	if node, exists := c.nodeDB.GetAllNodes()[myNodeNum]; exists {
+		user.LongName = node.LongName
+		user.ShortName = node.ShortName
+		user.HwModel = node.HwModel
+		user.Role = node.Role
+		user.PublicKey = node.PublicKey
+	}
+	return user
+}
+
+// GetMyNodeNum returns the node number of the local device, or 0 if not yet known
+func (c *Client) GetMyNodeNum() uint32 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.myNodeNum
+}
+
+// SetDebugMode enables or disables debug mode on the device
+func (c *Client) SetDebugMode(enabled bool) error {
+	if !c.connection.IsConnected() {
+		return fmt.Errorf("connection not available")
+	}
+
+	// Devices with a ToRadio stream are configured with AdminMessages
+	if _, ok := c.connection.(PacketSender); ok {
+		admin := c.Admin(0)
+		config, err := admin.GetConfig(pb.AdminMessage_SECURITY_CONFIG)
+		if err != nil {
+			return err
+		}
+		if config.GetSecurity() == nil {
+			return fmt.Errorf("device sent %s config instead of security", GetPayloadVariantName(config))
+		}
+		config.GetSecurity().DebugLogApiEnabled = enabled
+		return admin.SetConfig(config)
+	}
+
+	var cmd string
+	if enabled {
+		cmd = "--set debug_log_enabled true"
+	} else {
+		cmd = "--set debug_log_enabled false"
+	}
+
+	return c.connection.SendCommand(cmd)
+}
+
+// GetNodeDB returns the node database
+func (c *Client) GetNodeDB() *NodeDB {
+	return c.nodeDB
+}
+
+// GetChannels returns the channels received from the device
+func (c *Client) GetChannels() *ChannelTable {
+	return c.channels
+}
+
+// GetDeviceLog returns the device's own log lines
+func (c *Client) GetDeviceLog() *DeviceLog {
+	return c.deviceLog
+}
+
+// GetConfig returns the config sections received from the device
+func (c *Client) GetConfig() *ConfigStore {
+	return c.config
+}
+
+// ResolveChannel names the channel a packet was sent on. Packets the device
+// decoded carry a channel index, packets it couldn't decrypt the on-air
+// channel hash. Returns false for packets that didn't come from the mesh.
+func (c *Client) ResolveChannel(packet *Packet) (ChannelRef, bool) {
+	if !packet.FromMesh && !packet.Sent {
+		return ChannelRef{}, false
+	}
+	if packet.PKIEncrypted {
+		return ChannelRef{Index: -1, PKI: true}, true
+	}
+
+	if !packet.Encrypted {
+		ref := ChannelRef{Index: int32(packet.Channel)}
+		if channel, ok := c.channels.ByIndex(ref.Index); ok {
+			ref.Name, ref.Role, ref.Hash = channel.Name, channel.Role, channel.Hash
+		}
+		return ref, true
+	}
+
+	ref := ChannelRef{Index: -1, Hash: packet.Channel, FromHash: true}
+	if channel, ok := c.channels.ByHash(packet.Channel); ok {
+		ref.Name, ref.Index, ref.Role = channel.Name, channel.Index, channel.Role
+		return ref, true
+	}
+	if packet.DecryptedWith != "" {
+		ref.Name = packet.DecryptedWith
+		return ref, true
+	}
+	if keyring := c.GetKeyring(); keyring != nil {
+		for _, entry := range keyring.Entries() {
+			if entry.Hash == packet.Channel {
+				ref.Name = entry.Name
+				break
+			}
+		}
+	}
+	return ref, true
+}
+
+// GetNodeName returns the friendly name for a node ID
+func (c *Client) GetNodeName(nodeID uint32) string {
+	return c.nodeDB.GetNodeName(nodeID)
+}
+
+// GetNodeShortName returns the short name for a node ID
+func (c *Client) GetNodeShortName(nodeID uint32) string {
+	return c.nodeDB.GetNodeShortName(nodeID)
+}
+
+// handleRawData processes a payload from the connection. Stream transports
+// deliver whole FromRadio frames, the WiFi API delivers JSON or text.
+func (c *Client) handleRawData(data []byte) error {
+	c.logger.Printf("Received %d bytes of raw data: %X", len(data), data[:min(len(data), 32)])
+
+	if rec := c.getRecorder(); rec != nil {
+		if err := rec.RecordFrame(data, c.Now()); err != nil {
+			c.logger.Printf("Failed to record frame: %v", err)
+		}
+	}
+
+	// First, try to parse as JSON (for WiFi connections with synthetic data)
+	if packet, err := c.parseJSONPacket(data); err == nil {
+		c.logger.Printf("Parsed JSON packet successfully")
+		c.queuePacket(packet)
+		return nil
+	}
+
+	// Try to parse as FromRadio protobuf message (for serial and TCP connections)
+	packet, err := c.parseFromRadioMessage(data)
+	if err != nil {
+		c.logger.Printf("Failed to parse as FromRadio: %v", err)
+		// Try to handle as text data (CLI output, etc.)
+		return c.handleTextData(data)
+	}
+
+	c.logger.Printf("Parsed FromRadio message successfully: Type=%s, From=%s, To=%s",
+		packet.GetTypeName(), packet.GetFromHex(), packet.GetToHex())
+	globalPacketStats.IncrementPacketType(packet.Type)
+	c.queuePacket(packet)
+	return nil
+}
+
+// handleDebugLog turns a firmware debug line from the stream into a log record packet
+func (c *Client) handleDebugLog(line string) {
+	if rec := c.getRecorder(); rec != nil {
+		if err := rec.RecordLog(line, c.Now()); err != nil {
+			c.logger.Printf("Failed to record log line: %v", err)
+		}
+	}
+
+	record, _ := ParseLogLine(line)
+	c.queueLogRecord(record, line)
+}
+
+// queueLogRecord queues a log record packet for a text log line
+func (c *Client) queueLogRecord(record *LogRecord, line string) {
+	c.queuePacket(&Packet{
+		From:        0,
+		To:          0xFFFFFFFF,
+		Type:        PacketTypeLogRecord,
+		RxTime:      c.Now(),
+		DecodedData: record,
+		Raw:         []byte(line),
+	})
+}
+
+// queuePacket sends a packet to the processing channel, dropping it if the queue is full
+func (c *Client) queuePacket(packet *Packet) {
+	select {
+	case c.packets <- packet:
+		// Successfully queued
+	default:
+		c.logger.Println("Packet queue full, dropping packet")
+	}
+}
+
+// handleTextData processes text-based data from the device
+func (c *Client) handleTextData(data []byte) error {
+	text := string(data)
+	c.logger.Printf("Received text data: %s", strings.TrimSpace(text))
+
+	// Skip empty or whitespace-only messages
+	trimmed := strings.TrimSpace(text)
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	// Try to parse as JSON (for WiFi API responses)
+	if strings.HasPrefix(trimmed, "{") && strings.HasSuffix(trimmed, "}") {
+		return c.handleJSONData([]byte(trimmed))
+	}
+
+	// Firmware log lines belong in the device log
+	if record, ok := ParseLogLine(trimmed); ok {
+		c.queueLogRecord(record, trimmed)
+		return nil
+	}
+
+	// Create a text packet for CLI output
+	packetType := PacketTypeText
+	from := uint32(0)
+	to := uint32(0xFFFFFFFF)
+
+	// Enhanced packet type detection from text content
+	lowerText := strings.ToLower(text)
+
+	// Device startup and configuration patterns
+	if strings.Contains(lowerText, "connected to") || strings.Contains(lowerText, "starting up") ||
+		strings.Contains(lowerText, "device info") || strings.Contains(lowerText, "firmware") {
+		packetType = PacketTypeAdmin
+	} else if strings.Contains(lowerText, "channel") && (strings.Contains(lowerText, "settings") || strings.Contains(lowerText, "config")) {
+		packetType = PacketTypeAdmin
+	} else if strings.Contains(lowerText, "module") && strings.Contains(lowerText, "config") {
+		packetType = PacketTypeAdmin
+	} else if strings.Contains(lowerText, "preferences") || strings.Contains(lowerText, "setting") {
+		packetType = PacketTypeAdmin
+	} else if strings.Contains(lowerText, "my info") || strings.Contains(lowerText, "node id") ||
+		strings.Contains(lowerText, "owner") || strings.Contains(text, "!!") {
+		packetType = PacketTypeNodeInfo
+		// Check if this contains node database info
+		if nodeID := c.extractNodeInfoFromText(text); nodeID != 0 {
+			// Extract and store basic node info from text
+			c.extractAndStoreNodeInfoFromText(text, nodeID)
+		}
+	} else if strings.Contains(lowerText, "rx:") || strings.Contains(lowerText, "tx:") {
+		packetType = PacketTypeRouting
+	} else if strings.Contains(lowerText, "position") || strings.Contains(lowerText, "gps") ||
+		strings.Contains(lowerText, "lat") || strings.Contains(lowerText, "lon") {
+		packetType = PacketTypePosition
+	} else if strings.Contains(lowerText, "battery") || strings.Contains(lowerText, "voltage") ||
+		strings.Contains(lowerText, "telemetry") || strings.Contains(lowerText, "sensor") {
+		packetType = PacketTypeTelemetry
+	} else if strings.Contains(lowerText, "admin") || strings.Contains(lowerText, "config") {
+		packetType = PacketTypeAdmin
+	} else if strings.Contains(lowerText, "range") && strings.Contains(lowerText, "test") {
+		packetType = PacketTypeRangeTest
+	}
+
+	// Try to extract node IDs from text
+	if matches := nodeIDPattern.FindAllString(text, -1); len(matches) >= 2 {
+		// Found potential from/to addresses
+		if id, err := parseNodeID(matches[0]); err == nil {
+			from = id
+		}
+		if id, err := parseNodeID(matches[1]); err == nil {
+			to = id
+		}
+	} else if len(matches) == 1 {
+		if id, err := parseNodeID(matches[0]); err == nil {
+			from = id
+		}
+	}
+
+	packet := &Packet{
+		ID:     0,
+		From:   from,
+		To:     to,
+		Type:   packetType,
+		RxTime: c.Now(),
+		DecodedData: NewTextData(trimmed),
+		Raw: data,
+	}
+
+	select {
+	case c.packets <- packet:
+		// Successfully queued
+	default:
+		c.logger.Println("Packet queue full, dropping text packet")
+	}
+
+	return nil
+}
+
+// handleJSONData processes JSON-formatted data from the device
+func (c *Client) handleJSONData(data []byte) error {
+	c.logger.Printf("Received JSON data: %s", string(data))
+
+	// For now, treat JSON data as text packets
+	// In a full implementation, you'd parse specific JSON packet formats
+	packet := &Packet{
+		ID:     0,
+		From:   0,
+		To:     0xFFFFFFFF,
+		Type:   PacketTypeText,
+		RxTime: c.Now(),
+		DecodedData: NewTextData(string(data)),
+		Raw: data,
+	}
+
+	select {
+	case c.packets <- packet:
+		// Successfully queued
+	default:
+		c.logger.Println("Packet queue full, dropping JSON packet")
+	}
+
+	return nil
+}
+
+// parseJSONPacket attempts to parse JSON data from WiFi synthetic packets
+func (c *Client) parseJSONPacket(data []byte) (*Packet, error) {
+	var jsonData map[string]interface{}
+	if err := json.Unmarshal(data, &jsonData); err != nil {
+		return nil, fmt.Errorf("not valid JSON: %w", err)
+	}
+
+	// Check if this looks like our synthetic packet format
+	packetType, hasType := jsonData["type"]
+	if !hasType {
+		return nil, fmt.Errorf("JSON data missing type field")
+	}
+
+	typeStr, ok := packetType.(string)
+	if !ok {
+		return nil, fmt.Errorf("type field is not a string")
+	}
+
+	switch typeStr {
+	case "device_status":
+		return c.parseDeviceStatusPacket(jsonData)
+	default:
+		return nil, fmt.Errorf("unknown JSON packet type: %s", typeStr)
+	}
+}
+
+// parseFromRadioMessage decodes a FromRadio protobuf message from the stream protocol
+// into a Packet, dispatching on whichever payload variant the device sent
+func (c *Client) parseFromRadioMessage(data []byte) (*Packet, error) {
+	fromRadio := &pb.FromRadio{}
+	if err := proto.Unmarshal(data, fromRadio); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal FromRadio: %w", err)
+	}
+	if fromRadio.GetPayloadVariant() == nil {
+		return nil, fmt.Errorf("FromRadio message has no payload variant")
+	}
+
+	// Everything except mesh packets is generated by the local device itself
+	packet := &Packet{
+		From:   0,
+		To:     0xFFFFFFFF,
+		RxTime: c.Now(),
+		Raw:    data,
+	}
+
+	switch v := fromRadio.GetPayloadVariant().(type) {
+	case *pb.FromRadio_Packet:
+		c.decodeMeshPacket(packet, v.Packet)
+
+	case *pb.FromRadio_MyInfo:
+		packet.Type = PacketTypeMyInfo
+		packet.DecodedData = v.MyInfo
+
+	case *pb.FromRadio_NodeInfo:
+		packet.Type = PacketTypeNodeInfo
+		packet.DecodedData = newNodeInfo(v.NodeInfo)
+
+	case *pb.FromRadio_Config:
+		packet.Type = PacketTypeConfig
+		packet.DecodedData = v.Config
+
+	case *pb.FromRadio_LogRecord:
+		packet.Type = PacketTypeLogRecord
+		packet.DecodedData = v.LogRecord
+
+	case *pb.FromRadio_ConfigCompleteId:
+		packet.Type = PacketTypeConfigComplete
+		packet.DecodedData = &ConfigCompleteData{ID: v.ConfigCompleteId}
+
+	case *pb.FromRadio_Rebooted:
+		packet.Type = PacketTypeRebooted
+		packet.DecodedData = &RebootedData{Rebooted: v.Rebooted}
+
+	case *pb.FromRadio_ModuleConfig:
+		packet.Type = PacketTypeModuleConfig
+		packet.DecodedData = v.ModuleConfig
+
+	case *pb.FromRadio_Channel:
+		packet.Type = PacketTypeChannel
+		packet.DecodedData = v.Channel
+
+	case *pb.FromRadio_QueueStatus:
+		packet.Type = PacketTypeQueueStatus
+		packet.DecodedData = v.QueueStatus
+
+	case *pb.FromRadio_Metadata:
+		packet.Type = PacketTypeMetadata
+		packet.DecodedData = v.Metadata
+
+	case *pb.FromRadio_ClientNotification:
+		packet.Type = PacketTypeClientNotification
+		packet.DecodedData = v.ClientNotification
+
+	case *pb.FromRadio_FileInfo:
+		packet.Type = PacketTypeFileInfo
+		packet.DecodedData = v.FileInfo
+
+	default:
+		c.logger.Printf("Unhandled FromRadio variant %s", GetPayloadVariantName(fromRadio))
+		packet.Type = PacketTypeUnknown
+	}
+
+	c.logger.Printf("Parsed FromRadio %s: ID=%d, From=%08x, To=%08x, Type=%s",
+		GetPayloadVariantName(fromRadio), packet.ID, packet.From, packet.To, packet.GetTypeName())
+
+	return packet, nil
+}
+
+// DecodeFrame decodes a FromRadio frame outside of a session, for tools that
+// work on recorded frames. The packet doesn't update any NodeDB or statistics.
+func DecodeFrame(data []byte, rxTime time.Time) (*Packet, error) {
+	c := &Client{
+		logger:   log.New(io.Discard, "", 0),
+		clock:    func() time.Time { return rxTime },
+		nodeDB:   NewNodeDB(),
+		keyring:  NewKeyring(),
+		channels: NewChannelTable(),
+	}
+	return c.parseFromRadioMessage(data)
+}
+
+// decodeMeshPacket copies the header fields of a MeshPacket into packet and
+// decodes its payload according to the portnum of the Data message
+func (c *Client) decodeMeshPacket(packet *Packet, meshPacket *pb.MeshPacket) {
+	packet.FromMesh = true
+	packet.ID = meshPacket.GetId()
+	packet.From = meshPacket.GetFrom()
+	packet.To = meshPacket.GetTo()
+	packet.Channel = uint8(meshPacket.GetChannel())
+	packet.HopLimit = uint8(meshPacket.GetHopLimit())
+	packet.WantAck = meshPacket.GetWantAck()
+	packet.Priority = uint8(meshPacket.GetPriority())
+	packet.RxSNR = meshPacket.GetRxSnr()
+	packet.RxRSSI = meshPacket.GetRxRssi()
+	packet.PKIEncrypted = meshPacket.GetPkiEncrypted()
+	packet.KeyWarning = c.checkSenderKey(packet.From, meshPacket.GetPublicKey())
+
+	// hop_start is only sent by firmware 2.3+, older packets leave it at zero
+	if hopStart := meshPacket.GetHopStart(); hopStart >= meshPacket.GetHopLimit() {
+		packet.HopStart = uint8(hopStart)
+		packet.HopCount = uint8(hopStart - meshPacket.GetHopLimit())
+	}
+	if rxTime := meshPacket.GetRxTime(); rxTime != 0 {
+		packet.RxTime = time.Unix(int64(rxTime), 0)
+	}
+
+	switch p := meshPacket.GetPayloadVariant().(type) {
+	case *pb.MeshPacket_Decoded:
+		c.decodeData(packet, p.Decoded)
+
+	case *pb.MeshPacket_Encrypted:
+		// Relayed packets for channels the device doesn't have the key for
+		packet.Type = PacketTypeUnknown
+		packet.Payload = p.Encrypted
+		packet.Encrypted = true
+		if c.decryptPKI(packet, meshPacket) {
+			return
+		}
+		if keyring := c.GetKeyring(); keyring != nil {
+			if data, entry, ok := keyring.Decrypt(packet.Channel, packet.ID, packet.From, p.Encrypted); ok {
+				c.logger.Printf("Decrypted packet %08x locally with the key for channel %s", packet.ID, entry.Name)
+				packet.DecryptedWith = entry.Name
+				c.decodeData(packet, data)
+			}
+		}
+	}
+}
+
+// decryptPKI decrypts a direct message sent to our node, whose private key we
+// hold, using the sender's public key from the NodeDB or the packet
+func (c *Client) decryptPKI(packet *Packet, meshPacket *pb.MeshPacket) bool {
+	privateKey := c.getPrivateKey()
+	if privateKey == nil || packet.Channel != 0 || len(packet.Payload) <= pkiOverheadLen {
+		return false
+	}
+	if myNodeNum := c.GetMyNodeNum(); myNodeNum == 0 || packet.To != myNodeNum {
+		return false
+	}
+
+	senderKey := meshPacket.GetPublicKey()
+	if node, exists := c.nodeDB.GetNode(packet.From); exists && len(node.PublicKey) > 0 {
+		senderKey = node.PublicKey
+	}
+	if len(senderKey) == 0 {
+		return false
+	}
+
+	plaintext, err := DecryptPKI(privateKey, senderKey, packet.ID, packet.From, packet.Payload)
+	if err != nil {
+		c.logger.Printf("PKI decryption of packet %08x from %08x failed: %v", packet.ID, packet.From, err)
+		return false
+	}
+	data := &pb.Data{}
+	if err := proto.Unmarshal(plaintext, data); err != nil {
+		c.logger.Printf("PKI payload of packet %08x is not a Data message: %v", packet.ID, err)
+		return false
+	}
+
+	c.logger.Printf("Decrypted PKI packet %08x from %08x locally", packet.ID, packet.From)
+	packet.PKIEncrypted = true
+	packet.DecryptedWith = PKIKeyName
+	c.decodeData(packet, data)
+	return true
+}
+
+// checkSenderKey warns if a sender's public key doesn't match the NodeDB, or
+// the node changed keys within KeyChangeWarningPeriod
+func (c *Client) checkSenderKey(from uint32, packetKey []byte) string {
+	node, exists := c.nodeDB.GetNode(from)
+	if !exists {
+		return ""
+	}
+	if publicKeysDiffer(node.PublicKey, packetKey) {
+		return fmt.Sprintf("packet carries public key %s, NodeDB has %s", KeyFingerprint(packetKey), KeyFingerprint(node.PublicKey))
+	}
+	if !node.KeyChangedAt.IsZero() && c.Now().Sub(node.KeyChangedAt) < KeyChangeWarningPeriod {
+		return fmt.Sprintf("node changed public key at %s, was %s",
+			node.KeyChangedAt.Format("2006-01-02 15:04:05"), KeyFingerprint(node.PreviousPublicKey))
+	}
+	return ""
+}
+
+// decodeData sets the packet type from the Data portnum and decodes its payload
+func (c *Client) decodeData(packet *Packet, data *pb.Data) {
+	portnum := uint32(data.GetPortnum())
+	if packetType, exists := PortNumToPacketType[portnum]; exists {
+		packet.Type = packetType
+	} else {
+		c.logger.Printf("Unknown portnum %d, using UNKNOWN type", portnum)
+		packet.Type = PacketTypeUnknown
+	}
+	packet.Payload = data.GetPayload()
+	packet.RequestID = data.GetRequestId()
+	packet.DecodedData = decodePayload(packet.Type, packet.Payload)
+}
+
+// parseDeviceStatusPacket creates a packet from device status JSON
+func (c *Client) parseDeviceStatusPacket(jsonData map[string]interface{}) (*Packet, error) {
+	// Extract timestamp
+	timestamp, _ := jsonData["timestamp"].(float64)
+	rxTime := time.Unix(int64(timestamp), 0)
+
+	// Extract device info
+	deviceInfo, hasDeviceInfo := jsonData["device_info"].(map[string]interface{})
+	if !hasDeviceInfo {
+		return nil, fmt.Errorf("device_status packet missing device_info")
+	}
+
+	// Create a telemetry-like packet from the device status
+	packet := &Packet{
+		ID:       0,
+		From:     0x12345678, // Use a synthetic node ID
+		To:       0xFFFFFFFF, // Broadcast
+		Type:     PacketTypeTelemetry,
+		Channel:  0,
+		HopCount: 0,
+		HopLimit: 3,
+		RxTime:   rxTime,
+		Raw:      []byte(fmt.Sprintf("Device Status: %v", deviceInfo)),
+	}
+
+	// Extract telemetry data from device info
+	metrics := &DeviceMetrics{}
+	telemetry := &TelemetryData{
+		Time:    uint32(timestamp),
+		Variant: &pb.Telemetry_DeviceMetrics{DeviceMetrics: metrics},
+	}
+
+	// Extract power information if available
+	if power, hasPower := deviceInfo["power"].(map[string]interface{}); hasPower {
+		if battPct, hasBatt := power["battery_percent"]; hasBatt {
+			if battFloat, ok := battPct.(float64); ok {
+				metrics.BatteryLevel = proto.Uint32(uint32(battFloat))
+			}
+		}
+		if voltage, hasVolt := power["battery_voltage_mv"]; hasVolt {
+			if voltFloat, ok := voltage.(float64); ok {
+				metrics.Voltage = proto.Float32(float32(voltFloat) / 1000.0) // Convert mV to V
+			}
+		}
+	}
+
+	// Extract airtime information if available
+	if airtime, hasAirtime := deviceInfo["airtime"].(map[string]interface{}); hasAirtime {
+		if chanUtil, hasChanUtil := airtime["channel_utilization"]; hasChanUtil {
+			if utilFloat, ok := chanUtil.(float64); ok {
+				metrics.ChannelUtilization = proto.Float32(float32(utilFloat))
+			}
+		}
+		if txUtil, hasTxUtil := airtime["utilization_tx"]; hasTxUtil {
+			if txFloat, ok := txUtil.(float64); ok {
+				metrics.AirUtilTx = proto.Float32(float32(txFloat))
+			}
+		}
+	}
+
+	// Extract WiFi signal information if available
+	if wifi, hasWifi := deviceInfo["wifi"].(map[string]interface{}); hasWifi {
+		if rssi, hasRssi := wifi["rssi"]; hasRssi {
+			if rssiFloat, ok := rssi.(float64); ok {
+				packet.RxRSSI = int32(rssiFloat)
+			}
+		}
+	}
+
+	packet.DecodedData = telemetry
+	return packet, nil
+}
+
+// processPackets processes packets from the queue
+func (c *Client) processPackets() {
+	for packet := range c.packets {
+		// Device log lines aren't RF traffic and stay out of the statistics
+		if packet.Type == PacketTypeLogRecord {
+			c.updateDeviceLog(packet)
+			c.notifySubscribers(packet)
+			continue
+		}
+
+		// Update statistics
+		c.updateStatistics(packet)
+
+		// Update NodeDB with packet information
+		c.updateNodeDB(packet)
+		c.updateTopology(packet)
+		c.updateWaypoints(packet)
+		c.updateConfig(packet)
+		c.updateHandshake(packet)
+
+		// Match acks, naks and replies against packets we sent
+		c.updateDeliveries(packet)
+		c.updateSessionPasskeys(packet)
+		c.dispatchResponse(packet)
+
+		// Notify subscribers
+		c.notifySubscribers(packet)
+
+		c.logger.Printf("Processed packet: From=%s, To=%s, Type=%s",
+			packet.GetFromHex(), packet.GetToHex(), packet.GetTypeName())
+	}
+}
+
+// notifySubscribers passes a packet to every subscriber
+func (c *Client) notifySubscribers(packet *Packet) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, subscriber := range c.subscribers {
+		go subscriber.OnPacket(packet) // Process in goroutine to avoid blocking
+	}
+}
+
+// updateDeliveries applies ROUTING_APP responses to tracked deliveries
+func (c *Client) updateDeliveries(packet *Packet) {
+	routing, ok := packet.DecodedData.(*Routing)
+	if !ok || packet.RequestID == 0 {
+		return
+	}
+	if delivery, updated := c.deliveries.HandleRouting(packet.RequestID, packet.From, c.GetMyNodeNum(), routing); updated {
+		c.logger.Printf("Delivery of packet %08x to !%08x: %s", delivery.PacketID, delivery.To, delivery)
+	}
+}
+
+// updateStatistics updates packet statistics
+func (c *Client) updateStatistics(packet *Packet) {
+	c.stats.mu.Lock()
+	defer c.stats.mu.Unlock()
+
+	c.stats.TotalPackets++
+	c.stats.PacketsByType[packet.Type]++
+	c.stats.PacketsByChannel[packet.Channel]++
+	c.stats.LastPacketTime = packet.RxTime
+
+	// Update average RSSI and SNR
+	if packet.RxRSSI != 0 {
+		if c.stats.AverageRSSI == 0 {
+			c.stats.AverageRSSI = float32(packet.RxRSSI)
+		} else {
+			c.stats.AverageRSSI = (c.stats.AverageRSSI + float32(packet.RxRSSI)) / 2
+		}
+	}
+
+	if packet.RxSNR != 0 {
+		if c.stats.AverageSNR == 0 {
+			c.stats.AverageSNR = packet.RxSNR
+		} else {
+			c.stats.AverageSNR = (c.stats.AverageSNR + packet.RxSNR) / 2
+		}
+	}
+}
+
+// updateNodeDB updates the node database with information from the packet
+func (c *Client) updateNodeDB(packet *Packet) {
+	// Mesh packets tell us when and how well we last heard the sender
+	if packet.From != 0 {
+		c.nodeDB.HeardFrom(packet)
+	}
+
+	// Handle specific packet types that contain node information
+	switch packet.Type {
+	case PacketTypeMyInfo:
+		if myInfo, ok := packet.DecodedData.(*MyNodeInfo); ok {
+			c.logger.Printf("Local device is node %08x", myInfo.GetMyNodeNum())
+			c.mu.Lock()
+			c.myNodeNum = myInfo.GetMyNodeNum()
+			c.mu.Unlock()
+		}
+
+	case PacketTypeNodeInfo:
+		if nodeInfo, ok := packet.DecodedData.(*NodeInfo); ok {
+			c.logger.Printf("Updating NodeDB with node info from node %08x: %s (%s)", nodeInfo.Num, nodeInfo.LongName, nodeInfo.ShortName)
+			
+			// Extract node ID from the packet itself, use From field if nodeInfo.ID is not available 
+			nodeID := packet.From
+			if nodeInfo.Num != 0 {
+				nodeID = nodeInfo.Num
+			} else if nodeInfo.ID != "" {
+				if parsed, err := parseNodeID(nodeInfo.ID); err == nil {
+					nodeID = parsed
+				}
+			}
+			if nodeID != 0 {
+				c.nodeDB.UpdateFromNodeInfo(nodeID, nodeInfo)
+			}
+		}
+		// NODEINFO_APP packets from the mesh carry a bare User for the sender
+		if user, ok := packet.DecodedData.(*UserData); ok && packet.From != 0 {
+			c.logger.Printf("Updating NodeDB with user info from node %08x: %s (%s)", packet.From, user.LongName, user.ShortName)
+			c.nodeDB.UpdateUser(packet.From, user)
+		}
+
+	case PacketTypePosition:
+		// Position replies without a fix carry no coordinates, keep the last known position
+		if position, ok := packet.DecodedData.(*PositionData); ok && packet.From != 0 &&
+			(position.LatitudeI != nil || position.LongitudeI != nil) {
+			c.logger.Printf("Updating NodeDB with position data from node %08x", packet.From)
+			c.nodeDB.UpdatePosition(packet.From, position)
+			c.tracks.Add(packet.From, position, packet.RxTime)
+		}
+
+	case PacketTypeTelemetry:
+		if telemetry, ok := packet.DecodedData.(*TelemetryData); ok && packet.From != 0 {
+			c.logger.Printf("Updating NodeDB with telemetry data from node %08x", packet.From)
+			c.nodeDB.UpdateTelemetry(packet.From, telemetry)
+		}
+	}
+}
+
+// updateConfig records the channels and config sections from the config dump
+func (c *Client) updateConfig(packet *Packet) {
+	switch d := packet.DecodedData.(type) {
+	case *Channel:
+		c.logger.Printf("Channel %d: %s %q", d.GetIndex(), d.GetRole(), d.GetSettings().GetName())
+		c.channels.UpdateChannel(d)
+	case *Config:
+		c.config.UpdateConfig(d)
+		if lora := d.GetLora(); lora != nil {
+			c.channels.UpdateLoRaConfig(lora)
+		}
+	case *ModuleConfig:
+		c.config.UpdateModuleConfig(d)
+	}
+}
+
+// updateDeviceLog adds log records from the device to the device log
+func (c *Client) updateDeviceLog(packet *Packet) {
+	if record, ok := packet.DecodedData.(*LogRecord); ok {
+		c.deviceLog.Add(NewDeviceLogEntry(record, packet.RxTime))
+	}
+}
+
+// IsConnected returns true if the client is connected and started
+func (c *Client) IsConnected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.started && c.connection.IsConnected()
+}
+
+// GetConnectionInfo returns connection information
+func (c *Client) GetConnectionInfo() string {
+	if !c.IsConnected() {
+		return "Disconnected"
+	}
+
+	return c.connection.GetConnectionInfo()
+}
+
+// min returns the minimum of two integers
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// parseNodeID extracts node ID from string containing !12345678 or 0x12345678
+func parseNodeID(nodeStr string) (uint32, error) {
+	// Remove ! or 0x prefix
+	nodeStr = strings.TrimPrefix(nodeStr, "!")
+	nodeStr = strings.TrimPrefix(nodeStr, "0x")
+	nodeStr = strings.TrimPrefix(nodeStr, "0X")
+
+	// Parse as hex
+	id, err := strconv.ParseUint(nodeStr, 16, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(id), nil
+}
+
+// ParseNodeID parses a node number given as !hex, 0xhex or bare hex
+func ParseNodeID(nodeStr string) (uint32, error) {
+	id, err := parseNodeID(strings.TrimSpace(nodeStr))
+	if err != nil {
+		return 0, fmt.Errorf("invalid node ID %q: expected !hex or 0xhex", nodeStr)
+	}
+	return id, nil
+}
+
+// extractNodeInfoFromText attempts to extract a node ID from text output
+func (c *Client) extractNodeInfoFromText(text string) uint32 {
+	// Look for node ID patterns like "!12345678" or "Node: 0x12345678"
+	patterns := []string{
+		`!([0-9a-fA-F]{8})`,
+		`0x([0-9a-fA-F]{8})`,
+		`node.*?([0-9a-fA-F]{8})`,
+		`id.*?([0-9a-fA-F]{8})`,
+	}
+	
+	for _, pattern := range patterns {
+		re := regexp.MustCompile(`(?i)` + pattern)
+		matches := re.FindStringSubmatch(text)
+		if len(matches) > 1 {
+			if id, err := parseNodeID(matches[1]); err == nil {
+				return id
+			}
+		}
+	}
+	
+	return 0
+}
+
+// extractAndStoreNodeInfoFromText extracts and stores node information from text output
+func (c *Client) extractAndStoreNodeInfoFromText(text string, nodeID uint32) {
+	// Try to extract name information from various text formats
+	longName := ""
+	shortName := ""
+	id := fmt.Sprintf("!%08x", nodeID)
+	
+	// Look for name patterns in text
+	// Example: "Owner: John Doe (JD)" or "User: Alice Smith" or "Name: Bob"
+	namePatterns := []struct {
+		pattern string
+		longIdx int
+		shortIdx int
+	}{
+		{`(?i)owner:?\s*([^\(\n]+)\s*\(([^\)]+)\)`, 1, 2}, // "Owner: John Doe (JD)"
+		{`(?i)user:?\s*([^\(\n]+)\s*\(([^\)]+)\)`, 1, 2},  // "User: Alice Smith (AS)"
+		{`(?i)name:?\s*([^\(\n]+)\s*\(([^\)]+)\)`, 1, 2},  // "Name: Bob Jones (BJ)"
+		{`(?i)owner:?\s*([^\n]+)`, 1, 0},                   // "Owner: John Doe"
+		{`(?i)user:?\s*([^\n]+)`, 1, 0},                    // "User: Alice Smith"
+		{`(?i)name:?\s*([^\n]+)`, 1, 0},                    // "Name: Bob"
+	}
+	
+	for _, np := range namePatterns {
+		re := regexp.MustCompile(np.pattern)
+		matches := re.FindStringSubmatch(text)
+		if len(matches) > np.longIdx {
+			longName = strings.TrimSpace(matches[np.longIdx])
+			if np.shortIdx > 0 && len(matches) > np.shortIdx {
+				shortName = strings.TrimSpace(matches[np.shortIdx])
+			}
+			break
+		}
+	}
+	
+	// If we extracted any name info, store it
+	if longName != "" || shortName != "" {
+		c.logger.Printf("Extracted node info from text: %08x -> '%s' (%s)", nodeID, longName, shortName)
+		c.nodeDB.AddOrUpdateUserInfo(nodeID, id, longName, shortName)
+	}
+}
@@ -0,0 +1,168 @@
+package meshtastic
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+	client, err := NewClient(nil, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	return client
+}
+
+func marshalFromRadio(t *testing.T, fromRadio *pb.FromRadio) []byte {
+	t.Helper()
+	data, err := proto.Marshal(fromRadio)
+	if err != nil {
+		t.Fatalf("failed to marshal FromRadio: %v", err)
+	}
+	return data
+}
+
+func mustMarshal(t *testing.T, msg proto.Message) []byte {
+	t.Helper()
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("failed to marshal %T: %v", msg, err)
+	}
+	return data
+}
+
+// Test decoding of a MeshPacket, including fields numbered above 15
+func TestParseFromRadioMeshPacket(t *testing.T) {
+	client := newTestClient(t)
+
+	data := marshalFromRadio(t, &pb.FromRadio{
+		Id: 7,
+		PayloadVariant: &pb.FromRadio_Packet{Packet: &pb.MeshPacket{
+			From:      0x11223344,
+			To:        0xFFFFFFFF,
+			Channel:   2,
+			Id:        0xDEADBEEF,
+			HopLimit:  1,
+			HopStart:  3,
+			WantAck:   true,
+			RxSnr:     6.25,
+			RxRssi:    -97,
+			PublicKey: []byte{0x01, 0x02},
+			PayloadVariant: &pb.MeshPacket_Decoded{Decoded: &pb.Data{
+				Portnum: pb.PortNum_TEXT_MESSAGE_APP,
+				Payload: []byte("hello mesh"),
+			}},
+		}},
+	})
+
+	packet, err := client.parseFromRadioMessage(data)
+	if err != nil {
+		t.Fatalf("parseFromRadioMessage failed: %v", err)
+	}
+
+	if packet.Type != PacketTypeText {
+		t.Errorf("Expected type TEXT, got %s", packet.GetTypeName())
+	}
+	if packet.From != 0x11223344 || packet.To != 0xFFFFFFFF {
+		t.Errorf("Unexpected addressing: from=%s to=%s", packet.GetFromHex(), packet.GetToHex())
+	}
+	if packet.ID != 0xDEADBEEF {
+		t.Errorf("Expected ID 0xDEADBEEF, got 0x%08x", packet.ID)
+	}
+	if packet.Channel != 2 || packet.HopLimit != 1 || packet.HopCount != 2 {
+		t.Errorf("Unexpected channel/hops: channel=%d hops=%s", packet.Channel, packet.GetHopInfo())
+	}
+	if !packet.WantAck || packet.RxSNR != 6.25 || packet.RxRSSI != -97 {
+		t.Errorf("Unexpected radio fields: want_ack=%t snr=%.2f rssi=%d", packet.WantAck, packet.RxSNR, packet.RxRSSI)
+	}
+	text, ok := packet.DecodedData.(*TextData)
+	if !ok || text.Text != "hello mesh" {
+		t.Errorf("Expected decoded text 'hello mesh', got %#v", packet.DecodedData)
+	}
+}
+
+// Test that each non-packet FromRadio variant becomes a correctly typed packet
+func TestParseFromRadioVariants(t *testing.T) {
+	client := newTestClient(t)
+
+	tests := []struct {
+		name      string
+		fromRadio *pb.FromRadio
+		expected  PacketType
+	}{
+		{"my_info", &pb.FromRadio{PayloadVariant: &pb.FromRadio_MyInfo{MyInfo: &pb.MyNodeInfo{MyNodeNum: 0x1234}}}, PacketTypeMyInfo},
+		{"node_info", &pb.FromRadio{PayloadVariant: &pb.FromRadio_NodeInfo{NodeInfo: &pb.NodeInfo{Num: 0xABCD, User: &pb.User{LongName: "Base"}}}}, PacketTypeNodeInfo},
+		{"config", &pb.FromRadio{PayloadVariant: &pb.FromRadio_Config{Config: &pb.Config{PayloadVariant: &pb.Config_Lora{Lora: &pb.Config_LoRaConfig{HopLimit: 3}}}}}, PacketTypeConfig},
+		{"log_record", &pb.FromRadio{PayloadVariant: &pb.FromRadio_LogRecord{LogRecord: &pb.LogRecord{Message: "boot"}}}, PacketTypeLogRecord},
+		{"config_complete_id", &pb.FromRadio{PayloadVariant: &pb.FromRadio_ConfigCompleteId{ConfigCompleteId: 42}}, PacketTypeConfigComplete},
+		{"rebooted", &pb.FromRadio{PayloadVariant: &pb.FromRadio_Rebooted{Rebooted: true}}, PacketTypeRebooted},
+		{"moduleConfig", &pb.FromRadio{PayloadVariant: &pb.FromRadio_ModuleConfig{ModuleConfig: &pb.ModuleConfig{PayloadVariant: &pb.ModuleConfig_Mqtt{Mqtt: &pb.ModuleConfig_MQTTConfig{Enabled: true}}}}}, PacketTypeModuleConfig},
+		{"channel", &pb.FromRadio{PayloadVariant: &pb.FromRadio_Channel{Channel: &pb.Channel{Index: 1}}}, PacketTypeChannel},
+		{"queueStatus", &pb.FromRadio{PayloadVariant: &pb.FromRadio_QueueStatus{QueueStatus: &pb.QueueStatus{Free: 4, Maxlen: 16}}}, PacketTypeQueueStatus},
+		{"metadata", &pb.FromRadio{PayloadVariant: &pb.FromRadio_Metadata{Metadata: &pb.DeviceMetadata{FirmwareVersion: "2.6.11"}}}, PacketTypeMetadata},
+		{"clientNotification", &pb.FromRadio{PayloadVariant: &pb.FromRadio_ClientNotification{ClientNotification: &pb.ClientNotification{Message: "hi"}}}, PacketTypeClientNotification},
+		{"fileInfo", &pb.FromRadio{PayloadVariant: &pb.FromRadio_FileInfo{FileInfo: &pb.FileInfo{FileName: "/prefs/db.proto", SizeBytes: 10}}}, PacketTypeFileInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packet, err := client.parseFromRadioMessage(marshalFromRadio(t, tt.fromRadio))
+			if err != nil {
+				t.Fatalf("parseFromRadioMessage failed: %v", err)
+			}
+			if packet.Type != tt.expected {
+				t.Errorf("Expected type %s, got %s", PacketTypeNames[tt.expected], packet.GetTypeName())
+			}
+			if packet.DecodedData == nil {
+				t.Error("Expected decoded data, got nil")
+			}
+			if name := GetPayloadVariantName(tt.fromRadio); name != tt.name {
+				t.Errorf("Expected variant name %q, got %q", tt.name, name)
+			}
+		})
+	}
+}
+
+// Test that node_info entries from the config dump carry the node number as ID
+func TestParseFromRadioNodeInfo(t *testing.T) {
+	client := newTestClient(t)
+
+	data := marshalFromRadio(t, &pb.FromRadio{PayloadVariant: &pb.FromRadio_NodeInfo{NodeInfo: &pb.NodeInfo{
+		Num: 0x0badcafe,
+		User: &pb.User{
+			LongName:  "Hilltop Relay",
+			ShortName: "HTR",
+			HwModel:   pb.HardwareModel_RAK4631,
+			Role:      pb.Config_DeviceConfig_ROUTER,
+		},
+	}}})
+
+	packet, err := client.parseFromRadioMessage(data)
+	if err != nil {
+		t.Fatalf("parseFromRadioMessage failed: %v", err)
+	}
+	nodeInfo, ok := packet.DecodedData.(*NodeInfo)
+	if !ok {
+		t.Fatalf("Expected *NodeInfo, got %T", packet.DecodedData)
+	}
+	if nodeInfo.ID != "!0badcafe" || nodeInfo.LongName != "Hilltop Relay" || nodeInfo.ShortName != "HTR" {
+		t.Errorf("Unexpected node info: %+v", nodeInfo)
+	}
+	if nodeInfo.GetHardwareModelName() != "RAK4631" {
+		t.Errorf("Expected hardware RAK4631, got %s", nodeInfo.GetHardwareModelName())
+	}
+}
+
+// Test that frames without a payload variant are rejected
+func TestParseFromRadioEmpty(t *testing.T) {
+	client := newTestClient(t)
+
+	if _, err := client.parseFromRadioMessage(marshalFromRadio(t, &pb.FromRadio{Id: 3})); err == nil {
+		t.Error("Expected error for FromRadio without payload variant")
+	}
+}
@@ -0,0 +1,409 @@
+package meshtastic
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// defaultConfig holds the settings a factory reset installs for a client node,
+// where they differ from the protobuf zero values. Sections not listed here
+// default to all zero.
+var defaultConfig = map[string]proto.Message{
+	"device": &pb.Config_DeviceConfig{
+		NodeInfoBroadcastSecs: 3 * 60 * 60,
+	},
+	"position": &pb.Config_PositionConfig{
+		PositionBroadcastSecs:             15 * 60,
+		PositionBroadcastSmartEnabled:     true,
+		GpsUpdateInterval:                 120,
+		BroadcastSmartMinimumDistance:     100,
+		BroadcastSmartMinimumIntervalSecs: 30,
+		GpsMode:                           pb.Config_PositionConfig_ENABLED,
+		PositionFlags: uint32(pb.Config_PositionConfig_ALTITUDE | pb.Config_PositionConfig_ALTITUDE_MSL |
+			pb.Config_PositionConfig_SPEED | pb.Config_PositionConfig_HEADING | pb.Config_PositionConfig_DOP),
+	},
+	"power": &pb.Config_PowerConfig{
+		WaitBluetoothSecs: 60,
+		SdsSecs:           0xFFFFFFFF,
+		LsSecs:            300,
+		MinWakeSecs:       10,
+	},
+	"network": &pb.Config_NetworkConfig{
+		NtpServer: "meshtastic.pool.ntp.org",
+	},
+	"display": &pb.Config_DisplayConfig{
+		ScreenOnSecs: 10 * 60,
+	},
+	"lora": &pb.Config_LoRaConfig{
+		UsePreset:           true,
+		HopLimit:            3,
+		TxEnabled:           true,
+		Sx126XRxBoostedGain: true,
+	},
+	"bluetooth": &pb.Config_BluetoothConfig{
+		Enabled:  true,
+		FixedPin: 123456,
+	},
+	"security": &pb.Config_SecurityConfig{
+		SerialEnabled: true,
+	},
+	"mqtt": &pb.ModuleConfig_MQTTConfig{
+		Address:           "mqtt.meshtastic.org",
+		Username:          "meshdev",
+		Password:          "large4cats",
+		EncryptionEnabled: true,
+		Root:              "msh",
+	},
+}
+
+// uniqueConfigFields are generated per device, so never match a default
+var uniqueConfigFields = map[string]bool{
+	"public_key":  true,
+	"private_key": true,
+}
+
+// secretConfigFields are masked when displayed
+var secretConfigFields = map[string]bool{
+	"private_key": true,
+	"wifi_psk":    true,
+	"password":    true,
+}
+
+// ConfigField is one setting of a config section
+type ConfigField struct {
+	Name    string // Path within the section, e.g. "ipv4_config.ip"
+	Value   string
+	Default string
+	Changed bool // Differs from the firmware default
+}
+
+// ConfigSection is a Config or ModuleConfig variant as last sent by the device
+type ConfigSection struct {
+	Name   string // e.g. "lora" or "mqtt"
+	Module bool
+	Fields []ConfigField
+}
+
+// ConfigStore holds the Config and ModuleConfig sections from the config
+// dump. It is safe for concurrent use.
+type ConfigStore struct {
+	mu       sync.RWMutex
+	sections map[string]proto.Message
+}
+
+// NewConfigStore creates an empty config store
+func NewConfigStore() *ConfigStore {
+	return &ConfigStore{sections: make(map[string]proto.Message)}
+}
+
+// UpdateConfig stores the section set in a Config
+func (s *ConfigStore) UpdateConfig(config *pb.Config) {
+	s.update(config)
+}
+
+// UpdateModuleConfig stores the section set in a ModuleConfig
+func (s *ConfigStore) UpdateModuleConfig(config *pb.ModuleConfig) {
+	s.update(config)
+}
+
+// update stores the message in the oneof field of a Config or ModuleConfig
+func (s *ConfigStore) update(msg proto.Message) {
+	name, section := oneofMessage(msg)
+	if section == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sections[name] = section
+}
+
+// Sections returns the received sections, Config before ModuleConfig and
+// each in protobuf field order
+func (s *ConfigStore) Sections() []ConfigSection {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var sections []ConfigSection
+	for _, fd := range sectionFields() {
+		name := string(fd.Name())
+		msg, exists := s.sections[name]
+		if !exists {
+			continue
+		}
+		sections = append(sections, ConfigSection{
+			Name:   name,
+			Module: isModuleSection(fd),
+			Fields: configFields(msg, defaultConfig[name]),
+		})
+	}
+	return sections
+}
+
+// messages returns copies of the received section messages by name
+func (s *ConfigStore) messages() map[string]proto.Message {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	messages := make(map[string]proto.Message, len(s.sections))
+	for name, msg := range s.sections {
+		messages[name] = proto.Clone(msg)
+	}
+	return messages
+}
+
+// sectionFields returns the oneof fields of Config followed by those of
+// ModuleConfig, each in protobuf field order
+func sectionFields() []protoreflect.FieldDescriptor {
+	var sections []protoreflect.FieldDescriptor
+	for _, container := range []proto.Message{&pb.Config{}, &pb.ModuleConfig{}} {
+		fields := container.ProtoReflect().Descriptor().Oneofs().Get(0).Fields()
+		for i := 0; i < fields.Len(); i++ {
+			sections = append(sections, fields.Get(i))
+		}
+	}
+	return sections
+}
+
+// isModuleSection reports whether a section field belongs to ModuleConfig
+func isModuleSection(fd protoreflect.FieldDescriptor) bool {
+	return fd.ContainingMessage().FullName() == (&pb.ModuleConfig{}).ProtoReflect().Descriptor().FullName()
+}
+
+// sectionField returns the Config or ModuleConfig oneof field of a section
+func sectionField(name string) (protoreflect.FieldDescriptor, bool) {
+	for _, fd := range sectionFields() {
+		if string(fd.Name()) == name {
+			return fd, true
+		}
+	}
+	return nil, false
+}
+
+// newSection returns an empty message for a section field
+func newSection(fd protoreflect.FieldDescriptor) proto.Message {
+	return sectionContainer(fd).ProtoReflect().NewField(fd).Message().Interface()
+}
+
+// sectionContainer returns an empty Config or ModuleConfig for a section field
+func sectionContainer(fd protoreflect.FieldDescriptor) proto.Message {
+	if isModuleSection(fd) {
+		return &pb.ModuleConfig{}
+	}
+	return &pb.Config{}
+}
+
+// wrapSection returns a Config or ModuleConfig with the named section set to msg
+func wrapSection(name string, msg proto.Message) (proto.Message, error) {
+	fd, ok := sectionField(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown config section %q", name)
+	}
+	container := sectionContainer(fd)
+	container.ProtoReflect().Set(fd, protoreflect.ValueOfMessage(msg.ProtoReflect()))
+	return container, nil
+}
+
+// ConfigFields lists the settings of a section message, e.g. the
+// Config_LoRaConfig for "lora", against the firmware defaults
+func ConfigFields(section string, msg proto.Message) []ConfigField {
+	return configFields(msg, defaultConfig[section])
+}
+
+// SectionOf returns the name and message of the section set in a Config or ModuleConfig
+func SectionOf(config proto.Message) (string, proto.Message) {
+	return oneofMessage(config)
+}
+
+// oneofMessage returns the name and message of the oneof field set in msg
+func oneofMessage(msg proto.Message) (string, proto.Message) {
+	m := msg.ProtoReflect()
+	oneofs := m.Descriptor().Oneofs()
+	if oneofs.Len() == 0 {
+		return "", nil
+	}
+	field := m.WhichOneof(oneofs.Get(0))
+	if field == nil || field.Kind() != protoreflect.MessageKind {
+		return "", nil
+	}
+	return string(field.Name()), m.Get(field).Message().Interface()
+}
+
+// configFields lists the settings of a section against its defaults, with
+// nested messages flattened into dotted names. Deprecated fields are skipped.
+func configFields(msg, defaults proto.Message) []ConfigField {
+	var def protoreflect.Message
+	if defaults != nil {
+		def = defaults.ProtoReflect()
+	} else {
+		def = msg.ProtoReflect().Type().Zero()
+	}
+	return appendConfigFields(nil, "", msg.ProtoReflect(), def)
+}
+
+func appendConfigFields(fields []ConfigField, prefix string, msg, def protoreflect.Message) []ConfigField {
+	descriptors := msg.Descriptor().Fields()
+	for i := 0; i < descriptors.Len(); i++ {
+		fd := descriptors.Get(i)
+		if options, ok := fd.Options().(*descriptorpb.FieldOptions); ok && options.GetDeprecated() {
+			continue
+		}
+		name := prefix + string(fd.Name())
+		if fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() {
+			fields = appendConfigFields(fields, name+".", msg.Get(fd).Message(), def.Get(fd).Message())
+			continue
+		}
+
+		value := formatConfigValue(fd, msg.Get(fd))
+		defValue := formatConfigValue(fd, def.Get(fd))
+		field := ConfigField{
+			Name:    name,
+			Value:   value,
+			Default: defValue,
+			Changed: value != defValue && !uniqueConfigFields[string(fd.Name())],
+		}
+		if secretConfigFields[string(fd.Name())] {
+			field.Value = maskSecret(field.Value)
+			field.Default = maskSecret(field.Default)
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// formatConfigValue formats a field value for display, enums by name
+func formatConfigValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	if fd.IsList() {
+		list := v.List()
+		items := make([]string, list.Len())
+		for i := range items {
+			items[i] = formatScalar(fd, list.Get(i))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	return formatScalar(fd, v)
+}
+
+func formatScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if value := fd.Enum().Values().ByNumber(v.Enum()); value != nil {
+			return string(value.Name())
+		}
+		return fmt.Sprintf("%d", v.Enum())
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	case protoreflect.StringKind:
+		return fmt.Sprintf("%q", v.String())
+	default:
+		return v.String()
+	}
+}
+
+// maskSecret hides a secret value, keeping whether it is set
+func maskSecret(value string) string {
+	if value == "" || value == `""` {
+		return value
+	}
+	return "********"
+}
+
+// SetConfigValue sets a field by its dotted path from msg, e.g. "lora.hop_limit"
+// on a Config. The value is parsed as the field's type: enums by name or
+// number, bytes as base64 and repeated fields as a comma separated list.
+func SetConfigValue(msg proto.Message, path, value string) error {
+	m := msg.ProtoReflect()
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return fmt.Errorf("%s has no field %q", m.Descriptor().Name(), name)
+		}
+
+		if i < len(names)-1 {
+			if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+				return fmt.Errorf("%s is not a section", strings.Join(names[:i+1], "."))
+			}
+			m = m.Mutable(fd).Message()
+			continue
+		}
+
+		switch {
+		case fd.IsMap() || (fd.Kind() == protoreflect.MessageKind && !fd.IsList()):
+			return fmt.Errorf("%s is a section, not a setting", path)
+		case fd.IsList():
+			list := m.Mutable(fd).List()
+			list.Truncate(0)
+			for _, item := range strings.Split(value, ",") {
+				if item = strings.TrimSpace(item); item == "" {
+					continue
+				}
+				v, err := parseConfigScalar(fd, item)
+				if err != nil {
+					return fmt.Errorf("%s: %w", path, err)
+				}
+				list.Append(v)
+			}
+		default:
+			v, err := parseConfigScalar(fd, value)
+			if err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			m.Set(fd, v)
+		}
+	}
+	return nil
+}
+
+// parseConfigScalar parses a single value for a field
+func parseConfigScalar(fd protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(value, 0, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(value, 0, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(value, 0, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(value, 0, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.StringKind:
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, "base64:"))
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		if v := values.ByName(protoreflect.Name(strings.ToUpper(value))); v != nil {
+			return protoreflect.ValueOfEnum(v.Number()), nil
+		}
+		if n, err := strconv.ParseInt(value, 0, 32); err == nil {
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+		}
+		return protoreflect.Value{}, fmt.Errorf("unknown %s value %q", fd.Enum().Name(), value)
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field type %s", fd.Kind())
+	}
+}
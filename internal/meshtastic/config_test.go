@@ -0,0 +1,54 @@
+package meshtastic
+
+import (
+	"testing"
+
+	"go-mesh/pb/meshtastic"
+)
+
+// Test that config sections are listed in order with changed settings flagged
+func TestConfigSections(t *testing.T) {
+	client := newTestClient(t)
+	client.updateConfig(&Packet{DecodedData: &pb.ModuleConfig{PayloadVariant: &pb.ModuleConfig_Mqtt{
+		Mqtt: &pb.ModuleConfig_MQTTConfig{Address: "mqtt.meshtastic.org", Password: "hunter2", Root: "msh"},
+	}}})
+	client.updateConfig(&Packet{DecodedData: &pb.Config{PayloadVariant: &pb.Config_Lora{
+		Lora: &pb.Config_LoRaConfig{UsePreset: true, HopLimit: 5, TxEnabled: true, Sx126XRxBoostedGain: true, Region: pb.Config_LoRaConfig_EU_868},
+	}}})
+
+	sections := client.GetConfig().Sections()
+	if len(sections) != 2 || sections[0].Name != "lora" || sections[1].Name != "mqtt" || !sections[1].Module {
+		t.Fatalf("Expected lora then mqtt sections, got %+v", sections)
+	}
+
+	fields := make(map[string]ConfigField)
+	for _, section := range sections {
+		for _, field := range section.Fields {
+			fields[section.Name+"."+field.Name] = field
+		}
+	}
+	tests := []struct {
+		name    string
+		value   string
+		changed bool
+	}{
+		{"lora.hop_limit", "5", true},
+		{"lora.region", "EU_868", true},
+		{"lora.tx_enabled", "true", false},
+		{"lora.modem_preset", "LONG_FAST", false},
+		{"mqtt.address", `"mqtt.meshtastic.org"`, false},
+		{"mqtt.password", "********", true},
+		{"mqtt.encryption_enabled", "false", true},
+		{"mqtt.map_report_settings.publish_interval_secs", "0", false},
+	}
+	for _, test := range tests {
+		field, exists := fields[test.name]
+		if !exists {
+			t.Errorf("Expected field %s", test.name)
+			continue
+		}
+		if field.Value != test.value || field.Changed != test.changed {
+			t.Errorf("%s: expected %s (changed=%t), got %s (changed=%t)", test.name, test.value, test.changed, field.Value, field.Changed)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package meshtastic
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultChannelName is the name the firmware uses for the default channel
+// when its name is left empty (the LongFast modem preset)
+const DefaultChannelName = "LongFast"
+
+// DefaultPSK is the key selected by the one byte PSK 0x01 ("AQ=="). PSKs 0x02
+// to 0x0A select the same key with its last byte incremented.
+var DefaultPSK = []byte{
+	0xd4, 0xf1, 0xbb, 0x3a, 0x20, 0x29, 0x07, 0x59,
+	0xf0, 0xbc, 0xff, 0xab, 0xcf, 0x4e, 0x69, 0x01,
+}
+
+// ExpandPSK turns a channel PSK into the AES key the firmware uses. It returns
+// nil for an unencrypted channel (an empty PSK or 0x00).
+func ExpandPSK(psk []byte) ([]byte, error) {
+	switch {
+	case len(psk) == 0:
+		return nil, nil
+	case len(psk) == 1:
+		if psk[0] == 0 {
+			return nil, nil
+		}
+		key := append([]byte(nil), DefaultPSK...)
+		key[len(key)-1] += psk[0] - 1
+		return key, nil
+	case len(psk) <= 16:
+		// Short keys are padded with zeros, as the firmware does
+		key := make([]byte, 16)
+		copy(key, psk)
+		return key, nil
+	case len(psk) <= 32:
+		key := make([]byte, 32)
+		copy(key, psk)
+		return key, nil
+	default:
+		return nil, fmt.Errorf("PSK of %d bytes is too long", len(psk))
+	}
+}
+
+// ChannelHash returns the 8-bit hash sent on the air in MeshPacket.channel:
+// the XOR of the bytes of the channel name and of its expanded key
+func ChannelHash(name string, key []byte) uint8 {
+	var hash uint8
+	for i := 0; i < len(name); i++ {
+		hash ^= name[i]
+	}
+	for _, b := range key {
+		hash ^= b
+	}
+	return hash
+}
+
+// DecryptPayload decrypts a MeshPacket payload with AES-CTR. The nonce is the
+// packet ID as a little-endian uint64 followed by the sender's node number
+// as a little-endian uint32 and four zero bytes, as built by the firmware.
+func DecryptPayload(key []byte, packetID, from uint32, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aes.BlockSize)
+	binary.LittleEndian.PutUint64(nonce[0:8], uint64(packetID))
+	binary.LittleEndian.PutUint32(nonce[8:12], from)
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCTR(block, nonce).XORKeyStream(plaintext, ciphertext)
+	return plaintext, nil
+}
+
+// KeyringEntry is a channel whose PSK we know
+type KeyringEntry struct {
+	Name string
+	PSK  []byte // As configured, possibly the one byte shorthand
+	Key  []byte // Expanded AES key, nil for an unencrypted channel
+	Hash uint8
+}
+
+// Keyring holds the channel keys used to decrypt packets we relay but that
+// the device didn't decode. It is safe for concurrent use.
+type Keyring struct {
+	mu      sync.RWMutex
+	entries []*KeyringEntry
+}
+
+// NewKeyring returns a keyring holding the default channel key
+func NewKeyring() *Keyring {
+	k := &Keyring{}
+	k.Add(DefaultChannelName, []byte{0x01})
+	return k
+}
+
+// LoadKeyring reads a keyring file on top of the default channel key. Each
+// line holds a channel name and its base64 PSK separated by whitespace;
+// blank lines and lines starting with # are ignored.
+func LoadKeyring(path string) (*Keyring, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	k := NewKeyring()
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected a channel name and base64 PSK", path, lineNum)
+		}
+		psk, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid base64 PSK: %w", path, lineNum, err)
+		}
+		if err := k.Add(fields[0], psk); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNum, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Add adds a channel key, replacing any key with the same name and PSK
+func (k *Keyring) Add(name string, psk []byte) error {
+	key, err := ExpandPSK(psk)
+	if err != nil {
+		return err
+	}
+	entry := &KeyringEntry{Name: name, PSK: psk, Key: key, Hash: ChannelHash(name, key)}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	for i, existing := range k.entries {
+		if existing.Name == name && string(existing.Key) == string(key) {
+			k.entries[i] = entry
+			return nil
+		}
+	}
+	k.entries = append(k.entries, entry)
+	return nil
+}
+
+// Entries returns the keys in the order they were added
+func (k *Keyring) Entries() []*KeyringEntry {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return append([]*KeyringEntry(nil), k.entries...)
+}
+
+// Decrypt tries each key whose channel hash matches and returns the first
+// payload that decodes as a Data message with a known portnum
+func (k *Keyring) Decrypt(channelHash uint8, packetID, from uint32, ciphertext []byte) (*pb.Data, *KeyringEntry, bool) {
+	for _, entry := range k.Entries() {
+		if entry.Key == nil || entry.Hash != channelHash {
+			continue
+		}
+		plaintext, err := DecryptPayload(entry.Key, packetID, from, ciphertext)
+		if err != nil {
+			continue
+		}
+		data := &pb.Data{}
+		if err := proto.Unmarshal(plaintext, data); err != nil || data.GetPortnum() == pb.PortNum_UNKNOWN_APP {
+			continue
+		}
+		return data, entry, true
+	}
+	return nil, nil, false
+}
@@ -0,0 +1,92 @@
+package meshtastic
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go-mesh/pb/meshtastic"
+)
+
+// Test PSK expansion and the channel hash of the default LongFast channel
+func TestExpandPSK(t *testing.T) {
+	key, err := ExpandPSK([]byte{0x01})
+	if err != nil || !bytes.Equal(key, DefaultPSK) {
+		t.Fatalf("Expected the default key for AQ==, got %x (%v)", key, err)
+	}
+	if hash := ChannelHash(DefaultChannelName, key); hash != 0x08 {
+		t.Errorf("Expected LongFast hash 0x08, got 0x%02x", hash)
+	}
+
+	key, _ = ExpandPSK([]byte{0x03})
+	if key[15] != DefaultPSK[15]+2 || !bytes.Equal(key[:15], DefaultPSK[:15]) {
+		t.Errorf("Expected default key with last byte +2, got %x", key)
+	}
+	if key, _ := ExpandPSK([]byte{0x00}); key != nil {
+		t.Errorf("Expected no key for an unencrypted channel, got %x", key)
+	}
+	if key, _ := ExpandPSK([]byte{1, 2, 3, 4, 5}); len(key) != 16 || key[4] != 5 || key[5] != 0 {
+		t.Errorf("Expected short PSK padded to 16 bytes, got %x", key)
+	}
+	if _, err := ExpandPSK(make([]byte, 33)); err == nil {
+		t.Error("Expected error for a 33 byte PSK")
+	}
+}
+
+// Test that an encrypted packet for a keyring channel is decrypted and decoded
+func TestDecryptEncryptedMeshPacket(t *testing.T) {
+	dir := t.TempDir()
+	keyringPath := filepath.Join(dir, "keyring.txt")
+	os.WriteFile(keyringPath, []byte("# test channels\nSecret  AAECAwQFBgcICQoLDA0ODw==\n"), 0644)
+
+	keyring, err := LoadKeyring(keyringPath)
+	if err != nil {
+		t.Fatalf("LoadKeyring failed: %v", err)
+	}
+	if entries := keyring.Entries(); len(entries) != 2 || entries[0].Name != DefaultChannelName || entries[1].Name != "Secret" {
+		t.Fatalf("Expected default and Secret keys, got %+v", entries)
+	}
+	secret := keyring.Entries()[1]
+
+	plaintext := mustMarshal(t, &pb.Data{Portnum: pb.PortNum_TEXT_MESSAGE_APP, Payload: []byte("hidden")})
+	// CTR mode is symmetric, so encrypting is the same operation
+	ciphertext, err := DecryptPayload(secret.Key, 0x01020304, 0x11223344, plaintext)
+	if err != nil {
+		t.Fatalf("DecryptPayload failed: %v", err)
+	}
+
+	client := newTestClient(t)
+	client.SetKeyring(keyring)
+	packet, err := client.parseFromRadioMessage(marshalFromRadio(t, &pb.FromRadio{
+		PayloadVariant: &pb.FromRadio_Packet{Packet: &pb.MeshPacket{
+			From:           0x11223344,
+			To:             0xFFFFFFFF,
+			Id:             0x01020304,
+			Channel:        uint32(secret.Hash),
+			PayloadVariant: &pb.MeshPacket_Encrypted{Encrypted: ciphertext},
+		}},
+	}))
+	if err != nil {
+		t.Fatalf("parseFromRadioMessage failed: %v", err)
+	}
+	if !packet.Encrypted || packet.DecryptedWith != "Secret" {
+		t.Errorf("Expected packet decrypted with Secret, got encrypted=%t with=%q", packet.Encrypted, packet.DecryptedWith)
+	}
+	if text, ok := packet.DecodedData.(*TextData); !ok || text.Text != "hidden" {
+		t.Errorf("Expected decoded text 'hidden', got %#v", packet.DecodedData)
+	}
+
+	// The wrong sender gives a different nonce, so nothing decodes
+	packet, _ = client.parseFromRadioMessage(marshalFromRadio(t, &pb.FromRadio{
+		PayloadVariant: &pb.FromRadio_Packet{Packet: &pb.MeshPacket{
+			From:           0x55667788,
+			Id:             0x01020304,
+			Channel:        uint32(secret.Hash),
+			PayloadVariant: &pb.MeshPacket_Encrypted{Encrypted: ciphertext},
+		}},
+	}))
+	if packet.DecryptedWith != "" || packet.Type != PacketTypeUnknown || !bytes.Equal(packet.Payload, ciphertext) {
+		t.Errorf("Expected packet to stay encrypted, got type %s decrypted with %q", packet.GetTypeName(), packet.DecryptedWith)
+	}
+}
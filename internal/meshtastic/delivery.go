@@ -0,0 +1,172 @@
+package meshtastic
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+)
+
+// maxTrackedDeliveries limits how many sent packets the tracker remembers
+const maxTrackedDeliveries = 500
+
+// DeliveryState is the delivery status of a sent packet that requested an ack
+type DeliveryState int
+
+const (
+	DeliveryPending DeliveryState = iota
+	DeliveryAcked
+	DeliveryImplicitAck
+	DeliveryFailed
+)
+
+// DeliveryStateNames maps delivery states to display names
+var DeliveryStateNames = map[DeliveryState]string{
+	DeliveryPending:     "PENDING",
+	DeliveryAcked:       "ACKED",
+	DeliveryImplicitAck: "IMPLICIT_ACK",
+	DeliveryFailed:      "FAILED",
+}
+
+// Delivery records the state of one outgoing want_ack packet
+type Delivery struct {
+	PacketID  uint32           `json:"packet_id"`
+	To        uint32           `json:"to"`
+	Portnum   pb.PortNum       `json:"portnum"`
+	State     DeliveryState    `json:"state"`
+	Reason    pb.Routing_Error `json:"reason,omitempty"` // Routing error when State is DeliveryFailed
+	AckFrom   uint32           `json:"ack_from,omitempty"`
+	SentAt    time.Time        `json:"sent_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// String returns the state, with the failure reason for failed deliveries
+func (d Delivery) String() string {
+	if d.State == DeliveryFailed {
+		return fmt.Sprintf("%s: %s", DeliveryStateNames[d.State], d.Reason)
+	}
+	return DeliveryStateNames[d.State]
+}
+
+// IsFinal returns true once no further state changes are expected
+func (d Delivery) IsFinal() bool {
+	return d.State == DeliveryAcked || d.State == DeliveryFailed
+}
+
+// DeliverySubscriber is notified whenever a tracked packet changes state
+type DeliverySubscriber interface {
+	OnDelivery(Delivery)
+}
+
+// DeliverySubscriberFunc is a function adapter for DeliverySubscriber
+type DeliverySubscriberFunc func(Delivery)
+
+func (f DeliverySubscriberFunc) OnDelivery(d Delivery) {
+	f(d)
+}
+
+// DeliveryTracker correlates ROUTING_APP acks and naks with sent packets
+type DeliveryTracker struct {
+	mu          sync.RWMutex
+	deliveries  map[uint32]*Delivery
+	order       []uint32
+	subscribers []DeliverySubscriber
+}
+
+// NewDeliveryTracker creates an empty delivery tracker
+func NewDeliveryTracker() *DeliveryTracker {
+	return &DeliveryTracker{
+		deliveries: make(map[uint32]*Delivery),
+	}
+}
+
+// Subscribe registers a subscriber for delivery state changes
+func (t *DeliveryTracker) Subscribe(subscriber DeliverySubscriber) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.subscribers = append(t.subscribers, subscriber)
+}
+
+// Track starts tracking a sent packet as pending
+func (t *DeliveryTracker) Track(packetID, to uint32, portnum pb.PortNum) {
+	now := time.Now()
+	delivery := &Delivery{
+		PacketID:  packetID,
+		To:        to,
+		Portnum:   portnum,
+		State:     DeliveryPending,
+		SentAt:    now,
+		UpdatedAt: now,
+	}
+
+	t.mu.Lock()
+	t.deliveries[packetID] = delivery
+	t.order = append(t.order, packetID)
+	if len(t.order) > maxTrackedDeliveries {
+		delete(t.deliveries, t.order[0])
+		t.order = t.order[1:]
+	}
+	t.mu.Unlock()
+
+	t.notify(*delivery)
+}
+
+// HandleRouting applies a ROUTING_APP message sent in response to requestID.
+// An ack that comes from our own node means it heard the packet rebroadcast
+// by a neighbour, which is only an implicit ack for packets sent to others.
+// Acks are ignored until myNodeNum is known, as they can't be told apart.
+func (t *DeliveryTracker) HandleRouting(requestID, from, myNodeNum uint32, routing *Routing) (Delivery, bool) {
+	if _, ok := routing.GetVariant().(*pb.Routing_ErrorReason); !ok {
+		return Delivery{}, false
+	}
+	if myNodeNum == 0 && routing.GetErrorReason() == pb.Routing_NONE {
+		return Delivery{}, false
+	}
+
+	t.mu.Lock()
+	delivery, exists := t.deliveries[requestID]
+	if !exists || delivery.IsFinal() {
+		t.mu.Unlock()
+		return Delivery{}, false
+	}
+
+	reason := routing.GetErrorReason()
+	switch {
+	case reason != pb.Routing_NONE:
+		delivery.State = DeliveryFailed
+		delivery.Reason = reason
+	case from == myNodeNum && delivery.To != myNodeNum:
+		delivery.State = DeliveryImplicitAck
+	default:
+		delivery.State = DeliveryAcked
+	}
+	delivery.AckFrom = from
+	delivery.UpdatedAt = time.Now()
+	updated := *delivery
+	t.mu.Unlock()
+
+	t.notify(updated)
+	return updated, true
+}
+
+// Get returns the delivery state of a sent packet
+func (t *DeliveryTracker) Get(packetID uint32) (Delivery, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	delivery, exists := t.deliveries[packetID]
+	if !exists {
+		return Delivery{}, false
+	}
+	return *delivery, true
+}
+
+// notify sends a delivery update to all subscribers
+func (t *DeliveryTracker) notify(delivery Delivery) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, subscriber := range t.subscribers {
+		go subscriber.OnDelivery(delivery)
+	}
+}
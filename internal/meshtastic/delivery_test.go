@@ -0,0 +1,110 @@
+package meshtastic
+
+import (
+	"testing"
+
+	"go-mesh/pb/meshtastic"
+)
+
+func routingError(reason pb.Routing_Error) *Routing {
+	return &Routing{Variant: &pb.Routing_ErrorReason{ErrorReason: reason}}
+}
+
+// Test each way a tracked packet can be resolved by a ROUTING_APP response
+func TestDeliveryTrackerHandleRouting(t *testing.T) {
+	const myNode, remote = 0x1000, 0x2000
+
+	tests := []struct {
+		name     string
+		from     uint32
+		routing  *Routing
+		expected DeliveryState
+		reason   pb.Routing_Error
+	}{
+		{"ack from destination", remote, routingError(pb.Routing_NONE), DeliveryAcked, pb.Routing_NONE},
+		{"implicit ack from own node", myNode, routingError(pb.Routing_NONE), DeliveryImplicitAck, pb.Routing_NONE},
+		{"nak max retransmit", myNode, routingError(pb.Routing_MAX_RETRANSMIT), DeliveryFailed, pb.Routing_MAX_RETRANSMIT},
+		{"nak no route", remote, routingError(pb.Routing_NO_ROUTE), DeliveryFailed, pb.Routing_NO_ROUTE},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewDeliveryTracker()
+			tracker.Track(42, remote, pb.PortNum_TEXT_MESSAGE_APP)
+
+			delivery, updated := tracker.HandleRouting(42, tt.from, myNode, tt.routing)
+			if !updated {
+				t.Fatal("Expected tracked delivery to be updated")
+			}
+			if delivery.State != tt.expected || delivery.Reason != tt.reason {
+				t.Errorf("Expected %s/%s, got %s", DeliveryStateNames[tt.expected], tt.reason, delivery)
+			}
+		})
+	}
+}
+
+// Test that final states stick and unknown request IDs are ignored
+func TestDeliveryTrackerFinalState(t *testing.T) {
+	tracker := NewDeliveryTracker()
+	tracker.Track(7, 0x2000, pb.PortNum_TEXT_MESSAGE_APP)
+
+	if _, updated := tracker.HandleRouting(8, 0x2000, 0x1000, routingError(pb.Routing_NONE)); updated {
+		t.Error("Expected response to an untracked packet to be ignored")
+	}
+
+	tracker.HandleRouting(7, 0x2000, 0x1000, routingError(pb.Routing_NONE))
+	if _, updated := tracker.HandleRouting(7, 0x1000, 0x1000, routingError(pb.Routing_TIMEOUT)); updated {
+		t.Error("Expected acked delivery not to change")
+	}
+
+	delivery, tracked := tracker.Get(7)
+	if !tracked || delivery.State != DeliveryAcked {
+		t.Errorf("Expected ACKED, got %s", delivery)
+	}
+}
+
+// Test that acks are ignored until our node number is known
+func TestDeliveryTrackerUnknownNode(t *testing.T) {
+	tracker := NewDeliveryTracker()
+	tracker.Track(7, 0x2000, pb.PortNum_TEXT_MESSAGE_APP)
+
+	// Without our node number an implicit ack would look like the destination's
+	if _, updated := tracker.HandleRouting(7, 0x1000, 0, routingError(pb.Routing_NONE)); updated {
+		t.Error("Expected an ack to be ignored while our node number is unknown")
+	}
+	if delivery, _ := tracker.Get(7); delivery.State != DeliveryPending {
+		t.Errorf("Expected PENDING, got %s", delivery)
+	}
+
+	delivery, updated := tracker.HandleRouting(7, 0x1000, 0x1000, routingError(pb.Routing_NONE))
+	if !updated || delivery.State != DeliveryImplicitAck {
+		t.Errorf("Expected IMPLICIT_ACK once our node number is known, got %s", delivery)
+	}
+}
+
+// Test that the client correlates a decoded ROUTING_APP packet by request_id
+func TestClientUpdateDeliveries(t *testing.T) {
+	client := newTestClient(t)
+	client.deliveries.Track(0xCAFE, 0x2000, pb.PortNum_TEXT_MESSAGE_APP)
+
+	data := marshalFromRadio(t, &pb.FromRadio{PayloadVariant: &pb.FromRadio_Packet{Packet: &pb.MeshPacket{
+		From: 0x2000,
+		To:   0x1000,
+		PayloadVariant: &pb.MeshPacket_Decoded{Decoded: &pb.Data{
+			Portnum:   pb.PortNum_ROUTING_APP,
+			Payload:   mustMarshal(t, routingError(pb.Routing_NO_CHANNEL)),
+			RequestId: 0xCAFE,
+		}},
+	}}})
+
+	packet, err := client.parseFromRadioMessage(data)
+	if err != nil {
+		t.Fatalf("parseFromRadioMessage failed: %v", err)
+	}
+	client.updateDeliveries(packet)
+
+	delivery, _ := client.GetDelivery(0xCAFE)
+	if delivery.State != DeliveryFailed || delivery.Reason != pb.Routing_NO_CHANNEL {
+		t.Errorf("Expected FAILED: NO_CHANNEL, got %s", delivery)
+	}
+}
@@ -0,0 +1,285 @@
+package meshtastic
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+)
+
+// LogLevel is the severity of a device log line
+type LogLevel = pb.LogRecord_Level
+
+// Log levels the UI filters and highlights by
+const (
+	LogLevelAll     = pb.LogRecord_UNSET
+	LogLevelWarning = pb.LogRecord_WARNING
+)
+
+// DefaultDeviceLogSize is how many device log lines are kept
+const DefaultDeviceLogSize = 5000
+
+// LogLevels are the levels the device log can be filtered to, from
+// everything up to critical only
+var LogLevels = []LogLevel{
+	pb.LogRecord_UNSET,
+	pb.LogRecord_DEBUG,
+	pb.LogRecord_INFO,
+	pb.LogRecord_WARNING,
+	pb.LogRecord_ERROR,
+	pb.LogRecord_CRITICAL,
+}
+
+// NextLogLevel returns the filter level after level, wrapping back to everything
+func NextLogLevel(level LogLevel) LogLevel {
+	for i, l := range LogLevels {
+		if l == level {
+			return LogLevels[(i+1)%len(LogLevels)]
+		}
+	}
+	return LogLevels[0]
+}
+
+// logLevelNames maps the level names the firmware prints to log levels
+var logLevelNames = map[string]LogLevel{
+	"TRACE": pb.LogRecord_TRACE,
+	"DEBUG": pb.LogRecord_DEBUG,
+	"HEAP":  pb.LogRecord_DEBUG,
+	"INFO":  pb.LogRecord_INFO,
+	"WARN":  pb.LogRecord_WARNING,
+	"ERROR": pb.LogRecord_ERROR,
+	"CRIT":  pb.LogRecord_CRITICAL,
+}
+
+// ansiPattern matches the colour codes the firmware puts around level names
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// logLinePattern matches the firmware's text log format, e.g.
+// "DEBUG | 12:34:56 1234 [Router] Received ...". The clock is ??:??:?? until
+// the device has the time, the number after it is the uptime in seconds and
+// the thread name is optional.
+var logLinePattern = regexp.MustCompile(`^(TRACE|DEBUG|HEAP|INFO|WARN|ERROR|CRIT)\s*\|\s*(?:\?\?|\d{2}):(?:\?\?|\d{2}):(?:\?\?|\d{2})\s+\d+\s+(?:\[([^\]]*)\]\s*)?(.*)$`)
+
+// packetIDPattern matches the packet IDs the firmware logs, e.g. id=0x0badcafe
+var packetIDPattern = regexp.MustCompile(`\bid=0x([0-9a-fA-F]{1,8})\b`)
+
+// DefaultLogWindow is how close to a packet's arrival a log line that only
+// names the packet's sender or recipient has to be to be linked to it
+const DefaultLogWindow = 2 * time.Second
+
+// ParseLogLine splits a firmware text log line into a LogRecord. ok is false
+// if the line isn't in the firmware's format, the record then holds the whole line.
+func ParseLogLine(line string) (record *LogRecord, ok bool) {
+	line = strings.TrimSpace(ansiPattern.ReplaceAllString(line, ""))
+	m := logLinePattern.FindStringSubmatch(line)
+	if m == nil {
+		return &LogRecord{Message: line}, false
+	}
+	return &LogRecord{
+		Level:   logLevelNames[m[1]],
+		Source:  m[2],
+		Message: m[3],
+	}, true
+}
+
+// DeviceLogEntry is one line of the device's own log
+type DeviceLogEntry struct {
+	Time       time.Time // When the line was received
+	DeviceTime time.Time // The device's timestamp, zero if it sent none
+	Level      LogLevel
+	Source     string // Firmware thread or module that logged the line
+	Message    string
+	PacketID   uint32   // Packet ID the line logs, 0 if none
+	Nodes      []uint32 // Other node numbers the line names
+}
+
+// NewDeviceLogEntry converts a LogRecord received at rxTime
+func NewDeviceLogEntry(record *LogRecord, rxTime time.Time) DeviceLogEntry {
+	entry := DeviceLogEntry{
+		Time:    rxTime,
+		Level:   record.GetLevel(),
+		Source:  record.GetSource(),
+		Message: strings.TrimRight(record.GetMessage(), "\r\n"),
+	}
+	if record.GetTime() != 0 {
+		entry.DeviceTime = time.Unix(int64(record.GetTime()), 0)
+	}
+
+	// Pull out the packet and node IDs the line is about
+	rest := entry.Message
+	if m := packetIDPattern.FindStringSubmatch(rest); m != nil {
+		if id, err := parseNodeID(m[1]); err == nil {
+			entry.PacketID = id
+		}
+		rest = packetIDPattern.ReplaceAllString(rest, "")
+	}
+	for _, match := range nodeIDPattern.FindAllString(rest, -1) {
+		if id, err := parseNodeID(match); err == nil && id != 0 && id != BroadcastAddr {
+			entry.Nodes = append(entry.Nodes, id)
+		}
+	}
+	return entry
+}
+
+// Mentions reports whether the line is about packet: it logs the packet's ID,
+// or logs no packet ID but names its sender or recipient within window of
+// its arrival
+func (e DeviceLogEntry) Mentions(packet *Packet, window time.Duration) bool {
+	if e.PacketID != 0 {
+		return e.PacketID == packet.ID
+	}
+	if len(e.Nodes) == 0 {
+		return false
+	}
+	if d := e.Time.Sub(packet.RxTime); d > window || d < -window {
+		return false
+	}
+	for _, node := range e.Nodes {
+		if node == packet.From || node == packet.To {
+			return true
+		}
+	}
+	return false
+}
+
+// LevelName returns the level as the firmware prints it, blank if unknown
+func (e DeviceLogEntry) LevelName() string {
+	switch e.Level {
+	case pb.LogRecord_UNSET:
+		return ""
+	case pb.LogRecord_WARNING:
+		return "WARN"
+	case pb.LogRecord_CRITICAL:
+		return "CRIT"
+	default:
+		return e.Level.String()
+	}
+}
+
+// String formats the entry as a line of a saved log
+func (e DeviceLogEntry) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %-5s", e.Time.Format("2006-01-02 15:04:05.000"), e.LevelName())
+	if e.Source != "" {
+		fmt.Fprintf(&b, " [%s]", e.Source)
+	}
+	b.WriteString(" ")
+	b.WriteString(e.Message)
+	return b.String()
+}
+
+// DeviceLogFilter selects device log entries
+type DeviceLogFilter struct {
+	MinLevel LogLevel // UNSET for every line, including those without a level
+	Search   string   // Case-insensitive text the source or message must contain
+}
+
+// Match reports whether the entry passes the filter
+func (f DeviceLogFilter) Match(entry DeviceLogEntry) bool {
+	if f.MinLevel != pb.LogRecord_UNSET && entry.Level < f.MinLevel {
+		return false
+	}
+	if f.Search != "" {
+		search := strings.ToLower(f.Search)
+		if !strings.Contains(strings.ToLower(entry.Message), search) &&
+			!strings.Contains(strings.ToLower(entry.Source), search) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeviceLog keeps the most recent device log lines, separate from the RF packets
+type DeviceLog struct {
+	mu      sync.RWMutex
+	entries []DeviceLogEntry
+	limit   int
+}
+
+// NewDeviceLog creates a log keeping up to limit lines
+func NewDeviceLog(limit int) *DeviceLog {
+	return &DeviceLog{limit: limit}
+}
+
+// Add appends an entry, dropping the oldest once the log is full
+func (l *DeviceLog) Add(entry DeviceLogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+	if len(l.entries) > l.limit {
+		l.entries = append(l.entries[:0], l.entries[len(l.entries)-l.limit:]...)
+	}
+}
+
+// Len returns the number of lines kept
+func (l *DeviceLog) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.entries)
+}
+
+// Entries returns the lines passing filter, oldest first
+func (l *DeviceLog) Entries(filter DeviceLogFilter) []DeviceLogEntry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	var entries []DeviceLogEntry
+	for _, entry := range l.entries {
+		if filter.Match(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Related returns the lines about packet, oldest first
+func (l *DeviceLog) Related(packet *Packet, window time.Duration) []DeviceLogEntry {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	var entries []DeviceLogEntry
+	for _, entry := range l.entries {
+		if entry.Mentions(packet, window) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Clear removes every line
+func (l *DeviceLog) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = nil
+}
+
+// WriteText writes the lines passing filter as text, one per line
+func (l *DeviceLog) WriteText(w io.Writer, filter DeviceLogFilter) (int, error) {
+	entries := l.Entries(filter)
+	for _, entry := range entries {
+		if _, err := fmt.Fprintln(w, entry); err != nil {
+			return 0, err
+		}
+	}
+	return len(entries), nil
+}
+
+// Save writes the lines passing filter to a new file at path and returns how
+// many were written
+func (l *DeviceLog) Save(path string, filter DeviceLogFilter) (int, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	n, err := l.WriteText(f, filter)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return n, nil
+}
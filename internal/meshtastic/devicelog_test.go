@@ -0,0 +1,135 @@
+package meshtastic
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+)
+
+// Test parsing the firmware's text log format, with and without colour codes
+func TestParseLogLine(t *testing.T) {
+	tests := []struct {
+		line    string
+		ok      bool
+		level   LogLevel
+		source  string
+		message string
+	}{
+		{"DEBUG | 12:34:56 1234 [Router] Received routing from=0x1234abcd, id=0x0badcafe", true,
+			pb.LogRecord_DEBUG, "Router", "Received routing from=0x1234abcd, id=0x0badcafe"},
+		{"INFO  | ??:??:?? 3 Booted, wake cause 0", true, pb.LogRecord_INFO, "", "Booted, wake cause 0"},
+		{"\x1b[33mWARN \x1b[0m| 01:02:03 99 [GPS] No GPS lock\r", true, pb.LogRecord_WARNING, "GPS", "No GPS lock"},
+		{"CRIT  | 00:00:01 1 [Main] Out of memory", true, pb.LogRecord_CRITICAL, "Main", "Out of memory"},
+		{"ets Jul 29 2019 12:21:46", false, pb.LogRecord_UNSET, "", "ets Jul 29 2019 12:21:46"},
+	}
+
+	for _, tt := range tests {
+		record, ok := ParseLogLine(tt.line)
+		if ok != tt.ok || record.GetLevel() != tt.level || record.GetSource() != tt.source || record.GetMessage() != tt.message {
+			t.Errorf("ParseLogLine(%q) = %v, %v; want %v %q %q, %v", tt.line, record, ok, tt.level, tt.source, tt.message, tt.ok)
+		}
+	}
+}
+
+// Test that log records and text log lines reach the device log, not the
+// packet statistics, and can be filtered and written out
+func TestDeviceLog(t *testing.T) {
+	client := newTestClient(t)
+
+	client.handleRawData(marshalFromRadio(t, &pb.FromRadio{PayloadVariant: &pb.FromRadio_LogRecord{LogRecord: &pb.LogRecord{
+		Message: "Lost connection to GPS\n",
+		Time:    1700000000,
+		Source:  "GPS",
+		Level:   pb.LogRecord_ERROR,
+	}}}))
+	client.handleDebugLog("DEBUG | 12:34:56 1234 [Router] Rebroadcasting received floodmsg")
+	client.handleTextData([]byte("INFO  | 12:34:57 1235 [Router] Received text msg from=0x1234abcd, id=0x0badcafe\r\n"))
+	close(client.packets)
+	client.processPackets()
+
+	if stats := client.GetStatistics(); stats.TotalPackets != 0 {
+		t.Errorf("Expected no packets counted, got %d", stats.TotalPackets)
+	}
+
+	deviceLog := client.GetDeviceLog()
+	entries := deviceLog.Entries(DeviceLogFilter{})
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 log lines, got %v", entries)
+	}
+	if entries[0].Message != "Lost connection to GPS" || entries[0].DeviceTime.Unix() != 1700000000 {
+		t.Errorf("Unexpected entry from LogRecord: %+v", entries[0])
+	}
+
+	filters := []struct {
+		filter DeviceLogFilter
+		want   int
+	}{
+		{DeviceLogFilter{MinLevel: pb.LogRecord_INFO}, 2},
+		{DeviceLogFilter{MinLevel: pb.LogRecord_ERROR}, 1},
+		{DeviceLogFilter{Search: "router"}, 2},
+		{DeviceLogFilter{MinLevel: pb.LogRecord_INFO, Search: "0BADCAFE"}, 1},
+	}
+	for _, f := range filters {
+		if got := len(deviceLog.Entries(f.filter)); got != f.want {
+			t.Errorf("Filter %+v: expected %d lines, got %d", f.filter, f.want, got)
+		}
+	}
+
+	var out strings.Builder
+	n, err := deviceLog.WriteText(&out, DeviceLogFilter{Search: "floodmsg"})
+	if err != nil || n != 1 {
+		t.Fatalf("WriteText returned %d, %v", n, err)
+	}
+	if !strings.HasSuffix(out.String(), "DEBUG [Router] Rebroadcasting received floodmsg\n") {
+		t.Errorf("Unexpected saved line %q", out.String())
+	}
+}
+
+// Test that the device log keeps only the newest lines
+func TestDeviceLogLimit(t *testing.T) {
+	deviceLog := NewDeviceLog(2)
+	for _, message := range []string{"one", "two", "three"} {
+		deviceLog.Add(DeviceLogEntry{Time: time.Now(), Message: message})
+	}
+
+	entries := deviceLog.Entries(DeviceLogFilter{})
+	if len(entries) != 2 || entries[0].Message != "two" || entries[1].Message != "three" {
+		t.Errorf("Expected the 2 newest lines, got %v", entries)
+	}
+}
+
+// Test linking log lines to the packet they describe by packet ID, or by
+// node ID for lines logged around the packet's arrival
+func TestDeviceLogRelated(t *testing.T) {
+	now := time.Now()
+	packet := &Packet{ID: 0x0badcafe, From: 0x1234abcd, To: BroadcastAddr, RxTime: now}
+
+	deviceLog := NewDeviceLog(DefaultDeviceLogSize)
+	lines := []struct {
+		message string
+		offset  time.Duration
+	}{
+		{"Received text msg from=0x1234abcd, id=0x0badcafe, msg=hi", 0},
+		{"Ignore dupe incoming msg (id=0x0badcafe fr=0x1234abcd to=0xffffffff, HopLim=2)", time.Second},
+		{"Received routing from=0x1234abcd, id=0x11111111", 0},
+		{"Node status update for !1234abcd", time.Second},
+		{"Node status update for !1234abcd", time.Minute},
+		{"Update DB node 0x55556666", 0},
+	}
+	for _, line := range lines {
+		deviceLog.Add(NewDeviceLogEntry(&LogRecord{Message: line.message}, now.Add(line.offset)))
+	}
+
+	related := deviceLog.Related(packet, DefaultLogWindow)
+	if len(related) != 3 {
+		t.Fatalf("Expected 3 related lines, got %v", related)
+	}
+	if related[1].PacketID != 0x0badcafe || len(related[1].Nodes) != 1 || related[1].Nodes[0] != 0x1234abcd {
+		t.Errorf("Expected packet ID and sender from the dupe line, got %+v", related[1])
+	}
+	if related[2].PacketID != 0 || related[2].Time != now.Add(time.Second) {
+		t.Errorf("Expected the node line logged with the packet, got %+v", related[2])
+	}
+}
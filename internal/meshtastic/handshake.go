@@ -0,0 +1,191 @@
+package meshtastic
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Config IDs the firmware treats as requests for a partial dump, see
+// SPECIAL_NONCE_ONLY_CONFIG and SPECIAL_NONCE_ONLY_NODES in PhoneAPI.h
+const (
+	configOnlyNonce = 69420
+	nodesOnlyNonce  = 69421
+)
+
+// DefaultConfigTimeout is how long sends are held back waiting for the config dump
+const DefaultConfigTimeout = 30 * time.Second
+
+// ConfigRequester is implemented by connections that ask the device for its
+// config dump with ToRadio{want_config_id}
+type ConfigRequester interface {
+	StartConfig(configID uint32) error
+}
+
+// ConnectionState is how far the want_config handshake has got
+type ConnectionState int
+
+const (
+	StateConnecting      ConnectionState = iota // Config not requested yet
+	StateReceivingConfig                        // want_config_id sent, dump in progress
+	StateReady                                  // Matching config_complete_id received
+)
+
+var connectionStateNames = map[ConnectionState]string{
+	StateConnecting:      "connecting",
+	StateReceivingConfig: "receiving config",
+	StateReady:           "ready",
+}
+
+func (s ConnectionState) String() string {
+	if name, ok := connectionStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("ConnectionState(%d)", int(s))
+}
+
+// ConfigProgress describes the config dump received so far
+type ConfigProgress struct {
+	State    ConnectionState
+	ConfigID uint32    // Nonce sent as want_config_id
+	Nodes    int       // NodeInfo entries received
+	Channels int       // Channels received
+	Since    time.Time // When the state was entered
+}
+
+// handshake tracks the want_config exchange. Sends wait on ready while the
+// dump is in progress.
+type handshake struct {
+	mu       sync.Mutex
+	progress ConfigProgress
+	ready    chan struct{}
+}
+
+func newHandshake() *handshake {
+	return &handshake{
+		progress: ConfigProgress{State: StateConnecting, Since: time.Now()},
+		ready:    make(chan struct{}),
+	}
+}
+
+// newConfigID returns a random want_config_id, avoiding the special nonces
+func newConfigID() uint32 {
+	for {
+		id := rand.Uint32()
+		if id != 0 && id != configOnlyNonce && id != nodesOnlyNonce {
+			return id
+		}
+	}
+}
+
+// begin records that the dump was requested with configID
+func (h *handshake) begin(configID uint32) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.progress.State == StateReady {
+		// A new dump after a reconnect holds sends back again
+		h.ready = make(chan struct{})
+	}
+	h.progress = ConfigProgress{State: StateReceivingConfig, ConfigID: configID, Since: time.Now()}
+}
+
+// finish marks the handshake complete and releases held back sends
+func (h *handshake) finish() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.progress.State == StateReady {
+		return
+	}
+	h.progress.State = StateReady
+	h.progress.Since = time.Now()
+	close(h.ready)
+}
+
+// count adds received dump entries to the progress
+func (h *handshake) count(nodes, channels int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.progress.Nodes += nodes
+	h.progress.Channels += channels
+}
+
+// get returns a copy of the progress and the channel closed when it completes
+func (h *handshake) get() (ConfigProgress, <-chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.progress, h.ready
+}
+
+// requestConfig sends want_config_id with a fresh nonce. Connections that
+// can't request the dump have no handshake and are ready at once.
+func (c *Client) requestConfig() {
+	requester, ok := c.connection.(ConfigRequester)
+	if !ok {
+		c.handshake.finish()
+		return
+	}
+
+	configID := newConfigID()
+	c.handshake.begin(configID)
+	if err := requester.StartConfig(configID); err != nil {
+		// Without a dump the device still streams packets, so don't hold back sends
+		c.logger.Printf("Warning: failed to send config request: %v", err)
+		c.handshake.finish()
+	}
+}
+
+// updateHandshake counts the dump's entries and completes the handshake when
+// the config_complete_id matching our nonce arrives
+func (c *Client) updateHandshake(packet *Packet) {
+	progress, _ := c.handshake.get()
+	if progress.State != StateReceivingConfig {
+		return
+	}
+
+	switch packet.Type {
+	case PacketTypeNodeInfo:
+		if !packet.FromMesh {
+			c.handshake.count(1, 0)
+		}
+	case PacketTypeChannel:
+		c.handshake.count(0, 1)
+	case PacketTypeConfigComplete:
+		complete, ok := packet.DecodedData.(*ConfigCompleteData)
+		if !ok {
+			return
+		}
+		if complete.ID != progress.ConfigID {
+			// The end of a dump requested earlier, e.g. by a previous session
+			c.logger.Printf("Ignoring config_complete_id %d, waiting for %d", complete.ID, progress.ConfigID)
+			return
+		}
+		c.handshake.finish()
+		c.logger.Printf("Config dump complete: %d nodes, %d channels in %v",
+			progress.Nodes, progress.Channels, time.Since(progress.Since).Truncate(time.Millisecond))
+	}
+}
+
+// GetConfigProgress returns the state of the want_config handshake
+func (c *Client) GetConfigProgress() ConfigProgress {
+	progress, _ := c.handshake.get()
+	return progress
+}
+
+// WaitForConfig blocks until the config dump is complete or the timeout
+// expires. It returns at once if the dump was never requested.
+func (c *Client) WaitForConfig(timeout time.Duration) error {
+	progress, ready := c.handshake.get()
+	if progress.State != StateReceivingConfig {
+		return nil
+	}
+
+	select {
+	case <-ready:
+		return nil
+	case <-time.After(timeout):
+		progress, _ = c.handshake.get()
+		return fmt.Errorf("device config dump not complete after %v (%d nodes, %d channels received)",
+			timeout, progress.Nodes, progress.Channels)
+	}
+}
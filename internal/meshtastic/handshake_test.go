@@ -0,0 +1,110 @@
+package meshtastic
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// Test that the client requests the dump with a nonce, holds sends back until
+// the matching config_complete_id arrives and counts the dump's entries
+func TestConfigHandshake(t *testing.T) {
+	frames := make(chan []byte, 4)
+	sender := &fakeSender{sent: make(chan uint32, 4)}
+	sender.StreamSender = NewStreamSender(func(frame []byte) error {
+		frames <- frame
+		return nil
+	}, log.New(io.Discard, "", 0))
+
+	client, err := NewClient(sender, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	if err := client.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer client.Stop()
+
+	toRadio := &pb.ToRadio{}
+	if err := proto.Unmarshal((<-frames)[HEADER_LEN:], toRadio); err != nil {
+		t.Fatalf("failed to unmarshal ToRadio: %v", err)
+	}
+	nonce := toRadio.GetWantConfigId()
+	if nonce == 0 || client.GetConfigProgress().ConfigID != nonce {
+		t.Fatalf("Expected a random want_config_id, got %d", nonce)
+	}
+
+	sendErr := make(chan error, 1)
+	go func() {
+		_, err := client.SendData(pb.PortNum_TEXT_MESSAGE_APP, []byte("hi"), DefaultSendOptions())
+		sendErr <- err
+	}()
+
+	dump := []*pb.FromRadio{
+		{PayloadVariant: &pb.FromRadio_NodeInfo{NodeInfo: &pb.NodeInfo{Num: 0x1000}}},
+		{PayloadVariant: &pb.FromRadio_Channel{Channel: &pb.Channel{Role: pb.Channel_PRIMARY}}},
+		{PayloadVariant: &pb.FromRadio_ConfigCompleteId{ConfigCompleteId: nonce + 1}},
+	}
+	for _, fromRadio := range dump {
+		client.handleRawData(marshalFromRadio(t, fromRadio))
+	}
+	if client.WaitForConfig(50*time.Millisecond) == nil {
+		t.Fatal("Expected a config_complete_id for another nonce to be ignored")
+	}
+	select {
+	case <-sender.sent:
+		t.Fatal("Expected the send to be held back during the config dump")
+	default:
+	}
+
+	client.handleRawData(marshalFromRadio(t, &pb.FromRadio{PayloadVariant: &pb.FromRadio_ConfigCompleteId{ConfigCompleteId: nonce}}))
+	if err := client.WaitForConfig(time.Second); err != nil {
+		t.Fatalf("WaitForConfig failed: %v", err)
+	}
+	if err := <-sendErr; err != nil {
+		t.Fatalf("SendData failed: %v", err)
+	}
+
+	progress := client.GetConfigProgress()
+	if progress.State != StateReady || progress.Nodes != 1 || progress.Channels != 1 {
+		t.Errorf("Expected ready with 1 node and 1 channel, got %+v", progress)
+	}
+}
+
+// Test that a request waiting for the config dump doesn't hold up responses
+// the packet pipeline dispatches meanwhile
+func TestRequestWaitingForConfig(t *testing.T) {
+	client, sender := newAdminTestClient(t)
+	client.handshake.begin(1)
+
+	sendErr := make(chan error, 1)
+	go func() {
+		_, cancel, err := client.sendAndAwaitResponses(pb.PortNum_ADMIN_APP, nil, DefaultSendOptions())
+		if err == nil {
+			cancel()
+		}
+		sendErr <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	dispatched := make(chan struct{})
+	go func() {
+		client.dispatchResponse(&Packet{From: 0x2000, RequestID: 0x1234})
+		close(dispatched)
+	}()
+	select {
+	case <-dispatched:
+	case <-time.After(time.Second):
+		t.Fatal("Expected a response to be dispatched while the request waits for config")
+	}
+
+	client.handshake.finish()
+	<-sender.sent
+	if err := <-sendErr; err != nil {
+		t.Fatalf("sendAndAwaitResponses failed: %v", err)
+	}
+}
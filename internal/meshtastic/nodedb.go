@@ -1,119 +1,353 @@
-package meshtastic
-
-import (
-	"fmt"
-	"sync"
-	"go-mesh/internal/utils"
-)
-
-// SimpleNodeInfo holds basic node information for name resolution
-type SimpleNodeInfo struct {
-	ID        string
-	LongName  string
-	ShortName string
-}
-
-// NodeDB manages a database of known mesh nodes for name resolution
-type NodeDB struct {
-	mu    sync.RWMutex
-	nodes map[uint32]*SimpleNodeInfo // Map node ID to SimpleNodeInfo
-}
-
-// NewNodeDB creates a new node database
-func NewNodeDB() *NodeDB {
-	return &NodeDB{
-		nodes: make(map[uint32]*SimpleNodeInfo),
-	}
-}
-
-// AddOrUpdateUserInfo adds or updates user information for a node
-func (db *NodeDB) AddOrUpdateUserInfo(nodeID uint32, id, longName, shortName string) {
-	db.mu.Lock()
-	defer db.mu.Unlock()
-
-	existing, exists := db.nodes[nodeID]
-	if exists {
-		existing.ID = id
-		existing.LongName = longName
-		existing.ShortName = shortName
-	} else {
-		// Create new SimpleNodeInfo with user data
-		db.nodes[nodeID] = &SimpleNodeInfo{
-			ID:        id,
-			LongName:  longName,
-			ShortName: shortName,
-		}
-	}
-}
-
-// GetNodeName returns the friendly name for a node ID
-// Returns long name if available, otherwise short name, otherwise hex ID
-func (db *NodeDB) GetNodeName(nodeID uint32) string {
-	db.mu.RLock()
-	defer db.mu.RUnlock()
-
-	node, exists := db.nodes[nodeID]
-	if !exists {
-		// Return hex format for unknown nodes
-		return fmt.Sprintf("!%08x", nodeID)
-	}
-
-	if node.LongName != "" {
-		return utils.SanitizeForTerminal(node.LongName)
-	}
-	if node.ShortName != "" {
-		return utils.SanitizeForTerminal(node.ShortName)
-	}
-	if node.ID != "" {
-		return node.ID
-	}
-
-	// Fallback to hex
-	return fmt.Sprintf("!%08x", nodeID)
-}
-
-// GetNodeShortName returns the short name for a node ID
-// Returns short name if available, otherwise abbreviated long name, otherwise hex ID
-func (db *NodeDB) GetNodeShortName(nodeID uint32) string {
-	db.mu.RLock()
-	defer db.mu.RUnlock()
-
-	node, exists := db.nodes[nodeID]
-	if !exists {
-		return fmt.Sprintf("!%08x", nodeID)
-	}
-
-	if node.ShortName != "" {
-		return utils.SanitizeForTerminal(node.ShortName)
-	}
-	if node.LongName != "" {
-		// Sanitize and abbreviate long name to 8 characters max
-		sanitized := utils.SanitizeForTerminal(node.LongName)
-		if len(sanitized) <= 8 {
-			return sanitized
-		}
-		return sanitized[:8]
-	}
-
-	// Fallback to hex
-	return fmt.Sprintf("!%08x", nodeID)
-}
-
-// GetNodeCount returns the number of nodes in the database
-func (db *NodeDB) GetNodeCount() int {
-	db.mu.RLock()
-	defer db.mu.RUnlock()
-	return len(db.nodes)
-}
-
-// GetAllNodes returns all nodes as a map of nodeID -> SimpleNodeInfo
-func (db *NodeDB) GetAllNodes() map[uint32]*SimpleNodeInfo {
-	db.mu.RLock()
-	defer db.mu.RUnlock()
-
-	nodes := make(map[uint32]*SimpleNodeInfo)
-	for k, v := range db.nodes {
-		nodes[k] = v
-	}
-	return nodes
-}
+package meshtastic
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go-mesh/internal/utils"
+)
+
+// NodeRecord holds everything we know about a mesh node
+type NodeRecord struct {
+	Num       uint32        `json:"num"`
+	ID        string        `json:"id"`
+	LongName  string        `json:"long_name"`
+	ShortName string        `json:"short_name"`
+	HwModel   HardwareModel `json:"hw_model"`
+	Role      DeviceRole    `json:"role"`
+	PublicKey []byte        `json:"public_key,omitempty"`
+
+	// Set when the node starts using a different public key
+	PreviousPublicKey []byte    `json:"previous_public_key,omitempty"`
+	KeyChangedAt      time.Time `json:"key_changed_at"`
+
+	FirstSeen time.Time `json:"first_seen"`
+	LastHeard time.Time `json:"last_heard"`
+
+	// Latest reported state, nil until the node sends it
+	Position           *Position           `json:"position,omitempty"`
+	DeviceMetrics      *DeviceMetrics      `json:"device_metrics,omitempty"`
+	EnvironmentMetrics *EnvironmentMetrics `json:"environment_metrics,omitempty"`
+
+	// Signal from the last packet heard directly or via relays
+	HopsAway *uint32 `json:"hops_away,omitempty"`
+	LastRSSI int32   `json:"last_rssi,omitempty"`
+	LastSNR  float32 `json:"last_snr,omitempty"`
+
+	// Restored is set for records loaded from disk that haven't been updated this session
+	Restored bool `json:"-"`
+}
+
+// GetHardwareModelName returns the hardware model name for the node
+func (n *NodeRecord) GetHardwareModelName() string {
+	return GetHardwareModelName(n.HwModel)
+}
+
+// HasPosition returns true if the node has reported a usable position
+func (n *NodeRecord) HasPosition() bool {
+	return n.Position != nil && n.Position.LatitudeI != nil && n.Position.LongitudeI != nil &&
+		(n.Position.GetLatitudeI() != 0 || n.Position.GetLongitudeI() != 0)
+}
+
+// IsStale returns true if the node hasn't been heard within maxAge of now
+func (n *NodeRecord) IsStale(maxAge time.Duration, now time.Time) bool {
+	return n.LastHeard.IsZero() || now.Sub(n.LastHeard) > maxAge
+}
+
+// setPublicKey stores a key the node announced at now, remembering the old
+// one if it changed. An empty key leaves the current one in place.
+func (n *NodeRecord) setPublicKey(key []byte, now time.Time) {
+	if len(key) == 0 {
+		return
+	}
+	if publicKeysDiffer(n.PublicKey, key) {
+		n.PreviousPublicKey = n.PublicKey
+		n.KeyChangedAt = now
+	}
+	n.PublicKey = key
+}
+
+// NodeDB manages a database of known mesh nodes
+type NodeDB struct {
+	mu      sync.RWMutex
+	nodes   map[uint32]*NodeRecord // Map node ID to NodeRecord
+	changes uint64                 // Incremented on every update, used to detect unsaved changes
+	clock   func() time.Time       // Stamps first-seen times, the replay's clock when replaying
+}
+
+// NewNodeDB creates a new node database
+func NewNodeDB() *NodeDB {
+	return &NodeDB{
+		nodes: make(map[uint32]*NodeRecord),
+		clock: time.Now,
+	}
+}
+
+// SetClock sets the clock used to stamp records, such as a replay's clock
+func (db *NodeDB) SetClock(clock func() time.Time) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.clock = clock
+}
+
+// getOrCreate returns the record for a node about to be updated, creating it
+// if needed. Callers hold db.mu.
+func (db *NodeDB) getOrCreate(nodeID uint32) *NodeRecord {
+	node, exists := db.nodes[nodeID]
+	if !exists {
+		node = &NodeRecord{
+			Num:       nodeID,
+			ID:        fmt.Sprintf("!%08x", nodeID),
+			FirstSeen: db.clock(),
+		}
+		db.nodes[nodeID] = node
+	}
+	node.Restored = false
+	db.changes++
+	return node
+}
+
+// AddOrUpdateUserInfo adds or updates user information for a node
+func (db *NodeDB) AddOrUpdateUserInfo(nodeID uint32, id, longName, shortName string) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	node := db.getOrCreate(nodeID)
+	if id != "" {
+		node.ID = id
+	}
+	node.LongName = longName
+	node.ShortName = shortName
+}
+
+// UpdateUser stores a User received in a NODEINFO_APP packet
+func (db *NodeDB) UpdateUser(nodeID uint32, user *UserData) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	node := db.getOrCreate(nodeID)
+	if user.ID != "" {
+		node.ID = user.ID
+	}
+	node.LongName = user.LongName
+	node.ShortName = user.ShortName
+	node.HwModel = user.HwModel
+	node.Role = DeviceRole(user.Role)
+	node.setPublicKey(user.PublicKey, db.clock())
+}
+
+// UpdateFromNodeInfo merges an entry from the device's node database, sent
+// during the config dump
+func (db *NodeDB) UpdateFromNodeInfo(nodeID uint32, info *NodeInfo) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	node := db.getOrCreate(nodeID)
+	if info.ID != "" {
+		node.ID = info.ID
+	}
+	if info.LongName != "" || info.ShortName != "" {
+		node.LongName = info.LongName
+		node.ShortName = info.ShortName
+		node.HwModel = info.HwModel
+		node.Role = DeviceRole(info.Role)
+	}
+	node.setPublicKey(info.PublicKey, db.clock())
+	if info.Position != nil {
+		node.Position = info.Position
+	}
+	if info.DeviceMetrics != nil {
+		node.DeviceMetrics = info.DeviceMetrics
+	}
+	if info.HopsAway != nil {
+		hops := *info.HopsAway
+		node.HopsAway = &hops
+	}
+	if info.SNR != 0 {
+		node.LastSNR = info.SNR
+	}
+
+	// The device may have heard the node long before we connected
+	if info.LastHeard != 0 {
+		lastHeard := time.Unix(int64(info.LastHeard), 0)
+		if lastHeard.After(node.LastHeard) {
+			node.LastHeard = lastHeard
+		}
+		if lastHeard.Before(node.FirstSeen) {
+			node.FirstSeen = lastHeard
+		}
+	}
+}
+
+// UpdatePosition stores the latest position reported by a node
+func (db *NodeDB) UpdatePosition(nodeID uint32, position *Position) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.getOrCreate(nodeID).Position = position
+}
+
+// UpdateTelemetry stores the latest device or environment metrics reported by a node
+func (db *NodeDB) UpdateTelemetry(nodeID uint32, telemetry *Telemetry) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	node := db.getOrCreate(nodeID)
+	if dm := telemetry.GetDeviceMetrics(); dm != nil {
+		node.DeviceMetrics = dm
+	}
+	if em := telemetry.GetEnvironmentMetrics(); em != nil {
+		node.EnvironmentMetrics = em
+	}
+}
+
+// HeardFrom records that a mesh packet from the node was received
+func (db *NodeDB) HeardFrom(packet *Packet) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	node := db.getOrCreate(packet.From)
+	if packet.RxTime.After(node.LastHeard) {
+		node.LastHeard = packet.RxTime
+	}
+	if packet.HopStart != 0 {
+		hops := uint32(packet.HopCount)
+		node.HopsAway = &hops
+	}
+	if packet.RxRSSI != 0 || packet.RxSNR != 0 {
+		node.LastRSSI = packet.RxRSSI
+		node.LastSNR = packet.RxSNR
+	}
+}
+
+// GetNode returns a copy of the record for a node
+func (db *NodeDB) GetNode(nodeID uint32) (*NodeRecord, bool) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	node, exists := db.nodes[nodeID]
+	if !exists {
+		return nil, false
+	}
+	record := *node
+	return &record, true
+}
+
+// GetNodeName returns the friendly name for a node ID
+// Returns long name if available, otherwise short name, otherwise hex ID
+func (db *NodeDB) GetNodeName(nodeID uint32) string {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	node, exists := db.nodes[nodeID]
+	if !exists {
+		// Return hex format for unknown nodes
+		return fmt.Sprintf("!%08x", nodeID)
+	}
+
+	if node.LongName != "" {
+		return utils.SanitizeForTerminal(node.LongName)
+	}
+	if node.ShortName != "" {
+		return utils.SanitizeForTerminal(node.ShortName)
+	}
+	if node.ID != "" {
+		return node.ID
+	}
+
+	// Fallback to hex
+	return fmt.Sprintf("!%08x", nodeID)
+}
+
+// GetNodeShortName returns the short name for a node ID
+// Returns short name if available, otherwise abbreviated long name, otherwise hex ID
+func (db *NodeDB) GetNodeShortName(nodeID uint32) string {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	node, exists := db.nodes[nodeID]
+	if !exists {
+		return fmt.Sprintf("!%08x", nodeID)
+	}
+
+	if node.ShortName != "" {
+		return utils.SanitizeForTerminal(node.ShortName)
+	}
+	if node.LongName != "" {
+		// Sanitize and abbreviate long name to 8 characters max
+		sanitized := utils.SanitizeForTerminal(node.LongName)
+		if len(sanitized) <= 8 {
+			return sanitized
+		}
+		return sanitized[:8]
+	}
+
+	// Fallback to hex
+	return fmt.Sprintf("!%08x", nodeID)
+}
+
+// GetNodeCount returns the number of nodes in the database
+func (db *NodeDB) GetNodeCount() int {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return len(db.nodes)
+}
+
+// GetAllNodes returns copies of all nodes as a map of nodeID -> NodeRecord
+func (db *NodeDB) GetAllNodes() map[uint32]*NodeRecord {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	nodes := make(map[uint32]*NodeRecord)
+	for k, v := range db.nodes {
+		record := *v
+		nodes[k] = &record
+	}
+	return nodes
+}
+
+// Changes returns a counter that increases whenever the database is updated
+func (db *NodeDB) Changes() uint64 {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+	return db.changes
+}
+
+// Restore adds previously saved records, keeping any node already heard this session
+func (db *NodeDB) Restore(records []*NodeRecord) int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	restored := 0
+	for _, r := range records {
+		if r == nil {
+			continue
+		}
+		if _, exists := db.nodes[r.Num]; exists {
+			continue
+		}
+		record := *r
+		record.Restored = true
+		db.nodes[r.Num] = &record
+		restored++
+	}
+	return restored
+}
+
+// Prune removes nodes not heard within maxAge of now and returns how many were removed
+func (db *NodeDB) Prune(maxAge time.Duration, now time.Time) int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	pruned := 0
+	for num, node := range db.nodes {
+		if node.IsStale(maxAge, now) {
+			delete(db.nodes, num)
+			pruned++
+		}
+	}
+	if pruned > 0 {
+		db.changes++
+	}
+	return pruned
+}
@@ -0,0 +1,200 @@
+package meshtastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// nodeDBFileVersion is bumped when the file format changes incompatibly
+const nodeDBFileVersion = 1
+
+// DefaultNodeDBSaveInterval is how often pending NodeDB changes are written to disk
+const DefaultNodeDBSaveInterval = 30 * time.Second
+
+// NodeDBFile is the on-disk format of a saved NodeDB
+type NodeDBFile struct {
+	Version int           `json:"version"`
+	SavedAt time.Time     `json:"saved_at"`
+	Nodes   []*NodeRecord `json:"nodes"`
+}
+
+// DefaultNodeDBPath returns the NodeDB file in the user's config directory
+func DefaultNodeDBPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "nodedb.json"
+	}
+	return filepath.Join(dir, "go-mesh", "nodedb.json")
+}
+
+// ReadNodeDBFile reads a saved NodeDB
+func ReadNodeDBFile(path string) (*NodeDBFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file NodeDBFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse NodeDB file %s: %w", path, err)
+	}
+	if file.Version > nodeDBFileVersion {
+		return nil, fmt.Errorf("NodeDB file %s has unsupported version %d", path, file.Version)
+	}
+	return &file, nil
+}
+
+// WriteNodeDBFile saves nodes to path, replacing the file atomically
+func WriteNodeDBFile(path string, nodes map[uint32]*NodeRecord) error {
+	file := NodeDBFile{
+		Version: nodeDBFileVersion,
+		SavedAt: time.Now(),
+		Nodes:   make([]*NodeRecord, 0, len(nodes)),
+	}
+	for _, node := range nodes {
+		file.Nodes = append(file.Nodes, node)
+	}
+	sort.Slice(file.Nodes, func(i, j int) bool { return file.Nodes[i].Num < file.Nodes[j].Num })
+
+	data, err := json.MarshalIndent(&file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode NodeDB: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create NodeDB directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".nodedb-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create NodeDB file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write NodeDB file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write NodeDB file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace NodeDB file: %w", err)
+	}
+	return nil
+}
+
+// NodeDBStore persists a NodeDB to a JSON file, saving periodically when it
+// changes and once more when closed
+type NodeDBStore struct {
+	db       *NodeDB
+	path     string
+	interval time.Duration
+	logger   *log.Logger
+
+	mu        sync.Mutex
+	savedAt   uint64 // NodeDB change counter at the last save
+	started   bool
+	stopChan  chan struct{}
+	doneChan  chan struct{}
+	closeOnce sync.Once
+}
+
+// NewNodeDBStore creates a store for db backed by the file at path
+func NewNodeDBStore(db *NodeDB, path string, logger *log.Logger) *NodeDBStore {
+	return &NodeDBStore{
+		db:       db,
+		path:     path,
+		interval: DefaultNodeDBSaveInterval,
+		logger:   logger,
+		stopChan: make(chan struct{}),
+		doneChan: make(chan struct{}),
+	}
+}
+
+// Load restores saved nodes into the NodeDB. A missing file is not an error.
+func (s *NodeDBStore) Load() error {
+	file, err := ReadNodeDBFile(s.path)
+	if os.IsNotExist(err) {
+		s.logger.Printf("No saved NodeDB at %s, starting empty", s.path)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	restored := s.db.Restore(file.Nodes)
+	s.mu.Lock()
+	s.savedAt = s.db.Changes()
+	s.mu.Unlock()
+
+	s.logger.Printf("Loaded %d nodes from %s (saved %s)", restored, s.path, file.SavedAt.Format(time.RFC3339))
+	return nil
+}
+
+// Save writes the NodeDB to disk if it changed since the last save
+func (s *NodeDBStore) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changes := s.db.Changes()
+	if changes == s.savedAt {
+		return nil
+	}
+	if err := WriteNodeDBFile(s.path, s.db.GetAllNodes()); err != nil {
+		return err
+	}
+	s.savedAt = changes
+	return nil
+}
+
+// Start begins saving changes in the background
+func (s *NodeDBStore) Start() {
+	s.mu.Lock()
+	s.started = true
+	s.mu.Unlock()
+	go s.saveLoop()
+}
+
+// Close stops background saving and writes any pending changes
+func (s *NodeDBStore) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		started := s.started
+		s.mu.Unlock()
+		close(s.stopChan)
+		if started {
+			<-s.doneChan
+		}
+		err = s.Save()
+		if err == nil {
+			s.logger.Printf("Saved NodeDB to %s", s.path)
+		}
+	})
+	return err
+}
+
+// saveLoop writes pending changes every interval until Close
+func (s *NodeDBStore) saveLoop() {
+	defer close(s.doneChan)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Save(); err != nil {
+				s.logger.Printf("Failed to save NodeDB: %v", err)
+			}
+		case <-s.stopChan:
+			return
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package meshtastic
+
+import (
+	"io"
+	"log"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// Test that the config dump, mesh packets and telemetry all feed the same node record
+func TestNodeDBUpdatesFromPackets(t *testing.T) {
+	client := newTestClient(t)
+	lastHeard := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	dump := marshalFromRadio(t, &pb.FromRadio{PayloadVariant: &pb.FromRadio_NodeInfo{NodeInfo: &pb.NodeInfo{
+		Num:       0x0badcafe,
+		User:      &pb.User{LongName: "Hilltop Relay", ShortName: "HTR", HwModel: pb.HardwareModel_RAK4631, Role: pb.Config_DeviceConfig_ROUTER, PublicKey: []byte{1, 2, 3}},
+		Position:  &pb.Position{LatitudeI: proto.Int32(515000000), LongitudeI: proto.Int32(-1000000)},
+		LastHeard: uint32(lastHeard.Unix()),
+		HopsAway:  proto.Uint32(2),
+	}}})
+	telemetry := marshalFromRadio(t, &pb.FromRadio{PayloadVariant: &pb.FromRadio_Packet{Packet: &pb.MeshPacket{
+		From:     0x0badcafe,
+		To:       0xFFFFFFFF,
+		HopLimit: 2,
+		HopStart: 3,
+		RxSnr:    -4.5,
+		RxRssi:   -110,
+		PayloadVariant: &pb.MeshPacket_Decoded{Decoded: &pb.Data{
+			Portnum: pb.PortNum_TELEMETRY_APP,
+			Payload: mustMarshal(t, &pb.Telemetry{Variant: &pb.Telemetry_DeviceMetrics{DeviceMetrics: &pb.DeviceMetrics{BatteryLevel: proto.Uint32(87)}}}),
+		}},
+	}}})
+
+	for _, data := range [][]byte{dump, telemetry} {
+		packet, err := client.parseFromRadioMessage(data)
+		if err != nil {
+			t.Fatalf("parseFromRadioMessage failed: %v", err)
+		}
+		client.updateNodeDB(packet)
+	}
+
+	node, exists := client.GetNodeDB().GetAllNodes()[0x0badcafe]
+	if !exists {
+		t.Fatal("Expected node 0x0badcafe in NodeDB")
+	}
+	if node.LongName != "Hilltop Relay" || node.GetHardwareModelName() != "RAK4631" || node.Role != pb.Config_DeviceConfig_ROUTER {
+		t.Errorf("Unexpected user info: %+v", node)
+	}
+	if len(node.PublicKey) != 3 || !node.HasPosition() || GetLatitudeDegrees(node.Position) != 51.5 {
+		t.Errorf("Unexpected key/position: %+v", node)
+	}
+	if node.DeviceMetrics.GetBatteryLevel() != 87 {
+		t.Errorf("Expected battery 87, got %d", node.DeviceMetrics.GetBatteryLevel())
+	}
+	// The relayed telemetry packet is newer than the dump, so it sets hops and signal
+	if node.HopsAway == nil || *node.HopsAway != 1 || node.LastRSSI != -110 || node.LastSNR != -4.5 {
+		t.Errorf("Unexpected hops/signal: hops=%v rssi=%d snr=%.1f", node.HopsAway, node.LastRSSI, node.LastSNR)
+	}
+	if !node.FirstSeen.Equal(lastHeard) || !node.LastHeard.After(lastHeard) {
+		t.Errorf("Unexpected first seen %v / last heard %v", node.FirstSeen, node.LastHeard)
+	}
+}
+
+// Test that a position reply without a fix keeps the last known position
+func TestNodeDBKeepsPositionWithoutFix(t *testing.T) {
+	client := newTestClient(t)
+	client.GetNodeDB().UpdatePosition(0x1234, &Position{LatitudeI: proto.Int32(10), LongitudeI: proto.Int32(20)})
+
+	client.updateNodeDB(&Packet{From: 0x1234, Type: PacketTypePosition, RxTime: time.Now(), DecodedData: &Position{}})
+
+	node, _ := client.GetNodeDB().GetNode(0x1234)
+	if node.Position.GetLatitudeI() != 10 {
+		t.Errorf("Expected position to be kept, got %v", node.Position)
+	}
+}
+
+// Test that a saved NodeDB is restored in a new session and marked until heard again
+func TestNodeDBStoreRoundTrip(t *testing.T) {
+	logger := log.New(io.Discard, "", 0)
+	path := filepath.Join(t.TempDir(), "nodedb.json")
+
+	db := NewNodeDB()
+	db.UpdateUser(0x1000, &UserData{ID: "!00001000", LongName: "Base", ShortName: "BASE", HwModel: pb.HardwareModel_HELTEC_V3})
+	db.HeardFrom(&Packet{From: 0x1000, RxTime: time.Now().Add(-time.Hour)})
+	store := NewNodeDBStore(db, path, logger)
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	restored := NewNodeDB()
+	restored.AddOrUpdateUserInfo(0x1000, "!00001000", "Base (renamed)", "BASE")
+	restored.HeardFrom(&Packet{From: 0x2000, RxTime: time.Now()})
+	if err := NewNodeDBStore(restored, path, logger).Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	// Nodes already heard this session keep their current record
+	if name := restored.GetNodeName(0x1000); name != "Base (renamed)" {
+		t.Errorf("Expected current record to win, got %q", name)
+	}
+
+	fresh := NewNodeDB()
+	if err := NewNodeDBStore(fresh, path, logger).Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	node, exists := fresh.GetNode(0x1000)
+	if !exists || node.LongName != "Base" || node.HwModel != pb.HardwareModel_HELTEC_V3 || !node.Restored {
+		t.Fatalf("Unexpected restored node: %+v", node)
+	}
+
+	fresh.HeardFrom(&Packet{From: 0x1000, RxTime: time.Now()})
+	if node, _ := fresh.GetNode(0x1000); node.Restored {
+		t.Error("Expected Restored to clear once the node is heard")
+	}
+}
+
+// Test that a missing file loads as an empty NodeDB
+func TestNodeDBStoreMissingFile(t *testing.T) {
+	db := NewNodeDB()
+	store := NewNodeDBStore(db, filepath.Join(t.TempDir(), "missing.json"), log.New(io.Discard, "", 0))
+	if err := store.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if db.GetNodeCount() != 0 {
+		t.Errorf("Expected empty NodeDB, got %d nodes", db.GetNodeCount())
+	}
+}
+
+// Test that Prune removes only nodes not heard within the age limit
+func TestNodeDBPrune(t *testing.T) {
+	// A replay's clock, far from the wall clock
+	now := time.Unix(1700000000, 0)
+	db := NewNodeDB()
+	db.SetClock(func() time.Time { return now })
+	db.HeardFrom(&Packet{From: 0x1000, RxTime: now.Add(-48 * time.Hour)})
+	db.HeardFrom(&Packet{From: 0x2000, RxTime: now})
+	db.AddOrUpdateUserInfo(0x3000, "", "Never heard", "NH")
+
+	if node, _ := db.GetNode(0x3000); !node.FirstSeen.Equal(now) {
+		t.Errorf("Expected FirstSeen from the clock, got %v", node.FirstSeen)
+	}
+	if pruned := db.Prune(24*time.Hour, now); pruned != 2 {
+		t.Errorf("Expected 2 nodes pruned, got %d", pruned)
+	}
+	if _, exists := db.GetNode(0x2000); !exists || db.GetNodeCount() != 1 {
+		t.Errorf("Expected only the recent node to remain")
+	}
+}
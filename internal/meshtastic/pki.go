@@ -0,0 +1,174 @@
+package meshtastic
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// PKI-encrypted payloads end with an 8 byte CCM authentication tag and a
+// 4 byte extra nonce
+const (
+	pkiTagLen      = 8
+	pkiNonceLen    = 13
+	pkiOverheadLen = pkiTagLen + 4
+)
+
+// PKIKeyName is recorded in Packet.DecryptedWith for direct messages we
+// decrypted with our private key
+const PKIKeyName = "private key"
+
+// KeyChangeWarningPeriod is how long after a node changes its public key
+// its packets are flagged
+const KeyChangeWarningPeriod = 24 * time.Hour
+
+// LoadPrivateKey reads a node's Curve25519 private key, either as the 32 raw
+// bytes or as base64 text as shown in the device's security config (with or
+// without a "base64:" prefix)
+func LoadPrivateKey(path string) (*ecdh.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key := data
+	if len(data) != 32 {
+		text := strings.TrimPrefix(strings.TrimSpace(string(data)), "base64:")
+		key, err = base64.StdEncoding.DecodeString(text)
+		if err != nil {
+			return nil, fmt.Errorf("private key in %s is neither 32 raw bytes nor base64: %w", path, err)
+		}
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("private key in %s is %d bytes, expected 32", path, len(key))
+	}
+	return ecdh.X25519().NewPrivateKey(key)
+}
+
+// pkiSharedKey derives the AES-256 key for a pair of nodes: the SHA-256 of
+// their X25519 shared secret
+func pkiSharedKey(privateKey *ecdh.PrivateKey, publicKey []byte) ([]byte, error) {
+	remote, err := ecdh.X25519().NewPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	secret, err := privateKey.ECDH(remote)
+	if err != nil {
+		return nil, err
+	}
+	key := sha256.Sum256(secret)
+	return key[:], nil
+}
+
+// pkiNonce builds the CCM nonce the firmware uses: the packet ID as a
+// little-endian uint64 with the extra nonce over its upper half, then the
+// sender's node number, truncated to 13 bytes
+func pkiNonce(packetID, from, extraNonce uint32) []byte {
+	nonce := make([]byte, 16)
+	binary.LittleEndian.PutUint64(nonce[0:8], uint64(packetID))
+	binary.LittleEndian.PutUint32(nonce[8:12], from)
+	if extraNonce != 0 {
+		binary.LittleEndian.PutUint32(nonce[4:8], extraNonce)
+	}
+	return nonce[:pkiNonceLen]
+}
+
+// DecryptPKI decrypts a direct message encrypted with the sender's public key
+// and our private key, as AES-256-CCM. The authentication tag is checked, so
+// a wrong key is reported as an error rather than returning garbage.
+func DecryptPKI(privateKey *ecdh.PrivateKey, senderKey []byte, packetID, from uint32, payload []byte) ([]byte, error) {
+	if len(payload) <= pkiOverheadLen {
+		return nil, fmt.Errorf("PKI payload of %d bytes is too short", len(payload))
+	}
+	key, err := pkiSharedKey(privateKey, senderKey)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext := payload[:len(payload)-pkiOverheadLen]
+	tag := payload[len(ciphertext) : len(ciphertext)+pkiTagLen]
+	extraNonce := binary.LittleEndian.Uint32(payload[len(payload)-4:])
+	nonce := pkiNonce(packetID, from, extraNonce)
+
+	plaintext := ccmCrypt(block, nonce, ciphertext)
+	expected := ccmTag(block, nonce, plaintext)
+	if subtle.ConstantTimeCompare(expected, tag) != 1 {
+		return nil, fmt.Errorf("PKI authentication failed")
+	}
+	return plaintext, nil
+}
+
+// ccmCounter returns CCM counter block i for a 13 byte nonce (L=2)
+func ccmCounter(nonce []byte, i uint16) []byte {
+	block := make([]byte, aes.BlockSize)
+	block[0] = 1 // L-1
+	copy(block[1:], nonce)
+	binary.BigEndian.PutUint16(block[14:], i)
+	return block
+}
+
+// ccmCrypt encrypts or decrypts data with the CCM keystream, counters from 1
+func ccmCrypt(block cipher.Block, nonce, data []byte) []byte {
+	out := make([]byte, len(data))
+	stream := make([]byte, aes.BlockSize)
+	for i := 0; i < len(data); i += aes.BlockSize {
+		block.Encrypt(stream, ccmCounter(nonce, uint16(i/aes.BlockSize+1)))
+		end := min(i+aes.BlockSize, len(data))
+		for j := i; j < end; j++ {
+			out[j] = data[j] ^ stream[j-i]
+		}
+	}
+	return out
+}
+
+// ccmTag computes the encrypted CBC-MAC of the plaintext with an 8 byte tag
+// (M=8) and no associated data
+func ccmTag(block cipher.Block, nonce, plaintext []byte) []byte {
+	b0 := make([]byte, aes.BlockSize)
+	b0[0] = ((pkiTagLen-2)/2)<<3 | 1 // M' and L'
+	copy(b0[1:], nonce)
+	binary.BigEndian.PutUint16(b0[14:], uint16(len(plaintext)))
+
+	mac := make([]byte, aes.BlockSize)
+	block.Encrypt(mac, b0)
+	for i := 0; i < len(plaintext); i += aes.BlockSize {
+		chunk := plaintext[i:min(i+aes.BlockSize, len(plaintext))]
+		for j, b := range chunk {
+			mac[j] ^= b
+		}
+		block.Encrypt(mac, mac)
+	}
+
+	s0 := make([]byte, aes.BlockSize)
+	block.Encrypt(s0, ccmCounter(nonce, 0))
+	for i := range mac {
+		mac[i] ^= s0[i]
+	}
+	return mac[:pkiTagLen]
+}
+
+// KeyFingerprint abbreviates a public key for display as its first four bytes in hex
+func KeyFingerprint(key []byte) string {
+	if len(key) <= 4 {
+		return fmt.Sprintf("%x", key)
+	}
+	return fmt.Sprintf("%x...", key[:4])
+}
+
+// publicKeysDiffer returns true if both keys are known and not equal
+func publicKeysDiffer(a, b []byte) bool {
+	return len(a) > 0 && len(b) > 0 && !bytes.Equal(a, b)
+}
@@ -0,0 +1,151 @@
+package meshtastic
+
+import (
+	"crypto/aes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+)
+
+// encryptPKI builds a payload the way the sending firmware does
+func encryptPKI(t *testing.T, sender *ecdh.PrivateKey, recipientKey []byte, packetID, from, extraNonce uint32, plaintext []byte) []byte {
+	t.Helper()
+	key, err := pkiSharedKey(sender, recipientKey)
+	if err != nil {
+		t.Fatalf("pkiSharedKey failed: %v", err)
+	}
+	block, _ := aes.NewCipher(key)
+	nonce := pkiNonce(packetID, from, extraNonce)
+
+	payload := ccmCrypt(block, nonce, plaintext)
+	payload = append(payload, ccmTag(block, nonce, plaintext)...)
+	return binary.LittleEndian.AppendUint32(payload, extraNonce)
+}
+
+func newX25519Key(t *testing.T) *ecdh.PrivateKey {
+	t.Helper()
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	return key
+}
+
+// Test the CCM keystream and tag against RFC 3610 Packet Vector #1. The RFC
+// vectors all have associated data, which the firmware doesn't use, so the
+// tag without it was computed with Nettle's ccm_aes128_encrypt_message.
+func TestCCMKnownAnswer(t *testing.T) {
+	key, _ := hex.DecodeString("c0c1c2c3c4c5c6c7c8c9cacbcccdcecf")
+	nonce, _ := hex.DecodeString("00000003020100a0a1a2a3a4a5")
+	plaintext, _ := hex.DecodeString("08090a0b0c0d0e0f101112131415161718191a1b1c1d1e")
+	block, _ := aes.NewCipher(key)
+
+	ciphertext := ccmCrypt(block, nonce, plaintext)
+	if got := hex.EncodeToString(ciphertext); got != "588c979a61c663d2f066d0c2c0f989806d5f6b61dac384" {
+		t.Errorf("Unexpected ciphertext %s", got)
+	}
+	if got := hex.EncodeToString(ccmCrypt(block, nonce, ciphertext)); got != hex.EncodeToString(plaintext) {
+		t.Errorf("Expected decryption to return the plaintext, got %s", got)
+	}
+	if got := hex.EncodeToString(ccmTag(block, nonce, plaintext)); got != "7c2051a7ae200bcf" {
+		t.Errorf("Unexpected tag %s", got)
+	}
+}
+
+// Test that a direct message is decrypted with our private key and the
+// sender's key from the NodeDB, and flagged as PKI
+func TestDecryptPKIMeshPacket(t *testing.T) {
+	ours, theirs := newX25519Key(t), newX25519Key(t)
+	keyPath := filepath.Join(t.TempDir(), "private.key")
+	os.WriteFile(keyPath, []byte("base64:"+base64.StdEncoding.EncodeToString(ours.Bytes())+"\n"), 0600)
+
+	privateKey, err := LoadPrivateKey(keyPath)
+	if err != nil {
+		t.Fatalf("LoadPrivateKey failed: %v", err)
+	}
+
+	client := newTestClient(t)
+	client.myNodeNum = 0x99887766
+	client.SetPrivateKey(privateKey)
+	client.GetNodeDB().UpdateUser(0x11223344, &UserData{LongName: "Sender", PublicKey: theirs.PublicKey().Bytes()})
+
+	plaintext := mustMarshal(t, &pb.Data{Portnum: pb.PortNum_TEXT_MESSAGE_APP, Payload: []byte("just for you")})
+	payload := encryptPKI(t, theirs, ours.PublicKey().Bytes(), 0x0A0B0C0D, 0x11223344, 0x55AA55AA, plaintext)
+
+	frame := func(to uint32, payload []byte) []byte {
+		return marshalFromRadio(t, &pb.FromRadio{
+			PayloadVariant: &pb.FromRadio_Packet{Packet: &pb.MeshPacket{
+				From:           0x11223344,
+				To:             to,
+				Id:             0x0A0B0C0D,
+				PayloadVariant: &pb.MeshPacket_Encrypted{Encrypted: payload},
+			}},
+		})
+	}
+
+	packet, err := client.parseFromRadioMessage(frame(0x99887766, payload))
+	if err != nil {
+		t.Fatalf("parseFromRadioMessage failed: %v", err)
+	}
+	if !packet.PKIEncrypted || packet.DecryptedWith != PKIKeyName {
+		t.Errorf("Expected PKI packet decrypted locally, got pki=%t with=%q", packet.PKIEncrypted, packet.DecryptedWith)
+	}
+	if text, ok := packet.DecodedData.(*TextData); !ok || text.Text != "just for you" {
+		t.Errorf("Expected decoded text, got %#v", packet.DecodedData)
+	}
+
+	// Direct messages for other nodes aren't ours to decrypt
+	packet, _ = client.parseFromRadioMessage(frame(0x55667788, payload))
+	if packet.PKIEncrypted || packet.DecryptedWith != "" || packet.Type != PacketTypeUnknown {
+		t.Errorf("Expected packet for another node to stay encrypted, got type %s", packet.GetTypeName())
+	}
+
+	// A tampered payload fails authentication and stays encrypted
+	payload[0] ^= 0xFF
+	packet, _ = client.parseFromRadioMessage(frame(0x99887766, payload))
+	if packet.PKIEncrypted || packet.DecryptedWith != "" || packet.Type != PacketTypeUnknown {
+		t.Errorf("Expected tampered packet to stay encrypted, got type %s", packet.GetTypeName())
+	}
+}
+
+// Test that a node announcing a new public key is flagged on later packets
+func TestPublicKeyChangeWarning(t *testing.T) {
+	client := newTestClient(t)
+	oldKey, newKey := newX25519Key(t).PublicKey().Bytes(), newX25519Key(t).PublicKey().Bytes()
+	db := client.GetNodeDB()
+	now := time.Unix(1700000000, 0)
+	client.clock = func() time.Time { return now }
+	db.SetClock(client.clock)
+
+	db.UpdateUser(0x11223344, &UserData{LongName: "Sender", PublicKey: oldKey})
+	if warning := client.checkSenderKey(0x11223344, oldKey); warning != "" {
+		t.Errorf("Expected no warning for a matching key, got %q", warning)
+	}
+	if warning := client.checkSenderKey(0x11223344, newKey); !strings.Contains(warning, "NodeDB has "+KeyFingerprint(oldKey)) {
+		t.Errorf("Expected mismatch warning, got %q", warning)
+	}
+
+	db.UpdateUser(0x11223344, &UserData{LongName: "Sender", PublicKey: newKey})
+	node, _ := db.GetNode(0x11223344)
+	if !node.KeyChangedAt.Equal(now) || string(node.PreviousPublicKey) != string(oldKey) {
+		t.Fatalf("Expected key change to be recorded, got %+v", node)
+	}
+	if warning := client.checkSenderKey(0x11223344, nil); !strings.Contains(warning, "changed public key") {
+		t.Errorf("Expected key change warning, got %q", warning)
+	}
+
+	// The warning stops once the change is old news
+	now = now.Add(KeyChangeWarningPeriod)
+	if warning := client.checkSenderKey(0x11223344, nil); warning != "" {
+		t.Errorf("Expected no warning a day after the change, got %q", warning)
+	}
+}
@@ -0,0 +1,124 @@
+package meshtastic
+
+import (
+	"fmt"
+	"time"
+)
+
+// LinkState is what a ConnectionEvent reports about the transport's link
+type LinkState int
+
+const (
+	LinkLost     LinkState = iota // The link dropped, reconnecting starts after Delay
+	LinkRetrying                  // A reconnect attempt failed, the next follows after Delay
+	LinkRestored                  // Reconnected, the config handshake is sent again
+)
+
+var linkStateNames = map[LinkState]string{
+	LinkLost:     "lost",
+	LinkRetrying: "retrying",
+	LinkRestored: "restored",
+}
+
+func (s LinkState) String() string {
+	if name, ok := linkStateNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("LinkState(%d)", int(s))
+}
+
+// ConnectionEvent reports a transport losing or regaining its link
+type ConnectionEvent struct {
+	State   LinkState
+	Attempt int           // Reconnect attempts made so far
+	Delay   time.Duration // Wait before the next attempt
+	Err     error         // Why the link dropped or the attempt failed
+	Time    time.Time
+}
+
+// String describes the event for logs and the status line
+func (e ConnectionEvent) String() string {
+	switch e.State {
+	case LinkLost:
+		return fmt.Sprintf("connection lost (%v), reconnecting in %v", e.Err, e.Delay)
+	case LinkRetrying:
+		return fmt.Sprintf("reconnect attempt %d failed (%v), retrying in %v", e.Attempt, e.Err, e.Delay)
+	default:
+		return fmt.Sprintf("reconnected after %d attempts", e.Attempt)
+	}
+}
+
+// ConnectionEventSource is implemented by connections that reconnect by
+// themselves when their link drops
+type ConnectionEventSource interface {
+	SetConnectionEventHandler(handler func(ConnectionEvent))
+}
+
+// Backoff is the exponentially growing delay between reconnect attempts
+type Backoff struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// DefaultBackoff starts retrying after a second and backs off to 30 seconds
+var DefaultBackoff = Backoff{Min: time.Second, Max: 30 * time.Second}
+
+// Reconnect calls connect until it succeeds, doubling the delay after each
+// failure, and reports progress to emit. It returns false if done is closed
+// first. cause is why the link dropped.
+func (b Backoff) Reconnect(cause error, done <-chan struct{}, connect func() error, emit func(ConnectionEvent)) bool {
+	delay := b.Min
+	emit(ConnectionEvent{State: LinkLost, Delay: delay, Err: cause, Time: time.Now()})
+
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-done:
+			return false
+		case <-time.After(delay):
+		}
+
+		err := connect()
+		if err == nil {
+			emit(ConnectionEvent{State: LinkRestored, Attempt: attempt, Time: time.Now()})
+			return true
+		}
+		select {
+		case <-done:
+			return false
+		default:
+		}
+
+		delay *= 2
+		if delay > b.Max {
+			delay = b.Max
+		}
+		emit(ConnectionEvent{State: LinkRetrying, Attempt: attempt, Delay: delay, Err: err, Time: time.Now()})
+	}
+}
+
+// handleConnectionEvent records the link state for the UI and restarts the
+// config handshake once the link is back. NodeDB and statistics carry over.
+func (c *Client) handleConnectionEvent(event ConnectionEvent) {
+	c.mu.Lock()
+	c.linkEvent = &event
+	if event.State == LinkRestored {
+		c.reconnects++
+	}
+	c.mu.Unlock()
+
+	c.logger.Printf("Link %s: %s", event.State, event)
+	if event.State == LinkRestored {
+		c.requestConfig()
+	}
+}
+
+// GetLinkStatus returns the last connection event and how many times the
+// connection was restored. ok is false if the link never dropped.
+func (c *Client) GetLinkStatus() (event ConnectionEvent, reconnects int, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.linkEvent == nil {
+		return ConnectionEvent{}, 0, false
+	}
+	return *c.linkEvent, c.reconnects, true
+}
@@ -0,0 +1,93 @@
+package meshtastic
+
+import (
+	"errors"
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// Test that reconnect attempts back off exponentially up to the maximum and
+// every step is reported
+func TestBackoffReconnect(t *testing.T) {
+	backoff := Backoff{Min: time.Millisecond, Max: 3 * time.Millisecond}
+	attempts := 0
+	connect := func() error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("port not found")
+		}
+		return nil
+	}
+
+	var events []ConnectionEvent
+	emit := func(event ConnectionEvent) { events = append(events, event) }
+	if !backoff.Reconnect(io.EOF, make(chan struct{}), connect, emit) {
+		t.Fatal("Expected Reconnect to succeed")
+	}
+
+	want := []struct {
+		state   LinkState
+		attempt int
+		delay   time.Duration
+	}{
+		{LinkLost, 0, time.Millisecond},
+		{LinkRetrying, 1, 2 * time.Millisecond},
+		{LinkRetrying, 2, 3 * time.Millisecond},
+		{LinkRestored, 3, 0},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("Expected %d events, got %v", len(want), events)
+	}
+	for i, w := range want {
+		if events[i].State != w.state || events[i].Attempt != w.attempt || events[i].Delay != w.delay {
+			t.Errorf("Event %d: expected %v attempt %d delay %v, got %+v", i, w.state, w.attempt, w.delay, events[i])
+		}
+	}
+
+	done := make(chan struct{})
+	close(done)
+	if backoff.Reconnect(io.EOF, done, connect, emit) {
+		t.Error("Expected Reconnect to give up once the connection is closed")
+	}
+}
+
+// Test that a restored link restarts the config handshake with a new nonce
+// and keeps the NodeDB
+func TestReconnectRestartsHandshake(t *testing.T) {
+	frames := make(chan []byte, 4)
+	sender := &fakeSender{sent: make(chan uint32, 4)}
+	sender.StreamSender = NewStreamSender(func(frame []byte) error {
+		frames <- frame
+		return nil
+	}, log.New(io.Discard, "", 0))
+
+	client, err := NewClient(sender, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	client.nodeDB.AddOrUpdateUserInfo(0x1000, "!00001000", "Base", "BASE")
+	client.handshake.finish()
+
+	client.handleConnectionEvent(ConnectionEvent{State: LinkLost, Err: io.EOF, Time: time.Now()})
+	client.handleConnectionEvent(ConnectionEvent{State: LinkRestored, Attempt: 1, Time: time.Now()})
+
+	toRadio := &pb.ToRadio{}
+	if err := proto.Unmarshal((<-frames)[HEADER_LEN:], toRadio); err != nil {
+		t.Fatalf("failed to unmarshal ToRadio: %v", err)
+	}
+	progress := client.GetConfigProgress()
+	if progress.State != StateReceivingConfig || progress.ConfigID != toRadio.GetWantConfigId() {
+		t.Errorf("Expected a new config request, got %+v and want_config_id %d", progress, toRadio.GetWantConfigId())
+	}
+	if event, reconnects, ok := client.GetLinkStatus(); !ok || event.State != LinkRestored || reconnects != 1 {
+		t.Errorf("Expected restored link after 1 reconnect, got %+v, %d", event, reconnects)
+	}
+	if client.GetNodeName(0x1000) != "Base" {
+		t.Error("Expected the NodeDB to survive the reconnect")
+	}
+}
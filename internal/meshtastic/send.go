@@ -0,0 +1,37 @@
+package meshtastic
+
+import (
+	"go-mesh/pb/meshtastic"
+)
+
+// BroadcastAddr is the destination address for packets sent to all nodes
+const BroadcastAddr uint32 = 0xFFFFFFFF
+
+// DefaultHopLimit matches the firmware's default lora.hop_limit setting
+const DefaultHopLimit uint32 = 3
+
+// SendOptions controls how an outgoing MeshPacket is addressed and routed
+type SendOptions struct {
+	To           uint32 // Destination node number, BroadcastAddr for all nodes
+	Channel      uint32 // Channel index on the local device
+	HopLimit     uint32 // Maximum number of rebroadcasts
+	WantAck      bool   // Request a ROUTING_APP acknowledgement from the mesh
+	WantResponse bool   // Ask the destination application to reply
+	PKIEncrypted bool   // Have the device encrypt with the destination's public key
+}
+
+// DefaultSendOptions returns options for a broadcast on the primary channel
+func DefaultSendOptions() SendOptions {
+	return SendOptions{
+		To:       BroadcastAddr,
+		Channel:  0,
+		HopLimit: DefaultHopLimit,
+	}
+}
+
+// PacketSender is implemented by connections that can transmit MeshPackets
+// to the device as ToRadio frames. SendData returns the ID of the sent packet;
+// Client builds every request on it so sends are tracked and published.
+type PacketSender interface {
+	SendData(portnum pb.PortNum, payload []byte, opts SendOptions) (uint32, error)
+}
@@ -0,0 +1,321 @@
+package meshtastic
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"strings"
+	"sync"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// Meshtastic stream protocol constants, shared by the serial and TCP transports
+const (
+	START1                 = 0x94
+	START2                 = 0xC3
+	HEADER_LEN             = 4
+	MAX_TO_FROM_RADIO_SIZE = 512
+	WAKE_UP_LEN            = 32
+	MAX_LOG_LINE_LEN       = 1024
+)
+
+// DebugLogSource is implemented by connections whose stream carries firmware
+// debug output interleaved with the FromRadio frames
+type DebugLogSource interface {
+	SetDebugLogHandler(handler func(line string))
+}
+
+// StreamFramer splits a Meshtastic byte stream into FromRadio payloads.
+// Each frame is START1, START2, a big-endian 16-bit length and the payload.
+// Bytes seen outside a frame are debug log text and are collected into lines.
+type StreamFramer struct {
+	rxBuf   []byte
+	logBuf  []byte
+	onFrame func([]byte) error
+	onLog   func(string)
+	logger  *log.Logger
+}
+
+// NewStreamFramer creates a framer that passes complete payloads to onFrame
+// and complete debug log lines to onLog, which may be nil
+func NewStreamFramer(onFrame func([]byte) error, onLog func(string), logger *log.Logger) *StreamFramer {
+	return &StreamFramer{
+		rxBuf:   make([]byte, 0, HEADER_LEN+MAX_TO_FROM_RADIO_SIZE),
+		onFrame: onFrame,
+		onLog:   onLog,
+		logger:  logger,
+	}
+}
+
+// Write feeds raw bytes from the transport into the framer
+func (f *StreamFramer) Write(data []byte) (int, error) {
+	for _, b := range data {
+		f.ProcessByte(b)
+	}
+	return len(data), nil
+}
+
+// ProcessByte processes a single byte according to the Meshtastic stream protocol
+func (f *StreamFramer) ProcessByte(b byte) {
+	ptr := len(f.rxBuf)
+	f.rxBuf = append(f.rxBuf, b)
+
+	switch {
+	case ptr == 0:
+		// Looking for START1, anything else is debug output
+		if b != START1 {
+			f.rxBuf = f.rxBuf[:0]
+			f.handleLogByte(b)
+		}
+
+	case ptr == 1:
+		// Looking for START2
+		if b != START2 {
+			f.rxBuf = f.rxBuf[:0]
+		}
+
+	case ptr == HEADER_LEN-1:
+		// Just finished reading the header, validate length
+		packetLen := f.packetLen()
+		if packetLen > MAX_TO_FROM_RADIO_SIZE {
+			f.logger.Printf("Packet length %d exceeds maximum %d - discarding", packetLen, MAX_TO_FROM_RADIO_SIZE)
+			f.rxBuf = f.rxBuf[:0]
+			return
+		}
+		if packetLen == 0 {
+			f.emitFrame()
+		}
+
+	case ptr >= HEADER_LEN:
+		if len(f.rxBuf) >= HEADER_LEN+f.packetLen() {
+			f.emitFrame()
+		}
+	}
+}
+
+// Flush emits any partially received debug log line
+func (f *StreamFramer) Flush() {
+	f.emitLogLine()
+}
+
+// packetLen returns the payload length from the frame header
+func (f *StreamFramer) packetLen() int {
+	return (int(f.rxBuf[2]) << 8) + int(f.rxBuf[3])
+}
+
+// emitFrame hands the completed payload to onFrame and resets the buffer
+func (f *StreamFramer) emitFrame() {
+	// Copy, as handlers keep the payload as the packet's raw bytes
+	payload := make([]byte, len(f.rxBuf)-HEADER_LEN)
+	copy(payload, f.rxBuf[HEADER_LEN:])
+	f.rxBuf = f.rxBuf[:0]
+
+	if err := f.onFrame(payload); err != nil {
+		f.logger.Printf("Error handling payload: %v", err)
+	}
+}
+
+// handleLogByte collects debug output until a newline is seen
+func (f *StreamFramer) handleLogByte(b byte) {
+	if b == '\n' {
+		f.emitLogLine()
+		return
+	}
+	f.logBuf = append(f.logBuf, b)
+	if len(f.logBuf) >= MAX_LOG_LINE_LEN {
+		f.emitLogLine()
+	}
+}
+
+// emitLogLine passes the buffered debug line to onLog, skipping blank lines
+func (f *StreamFramer) emitLogLine() {
+	line := strings.TrimRight(strings.ToValidUTF8(string(f.logBuf), "?"), "\r")
+	f.logBuf = f.logBuf[:0]
+
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	if f.onLog != nil {
+		f.onLog(line)
+	} else {
+		f.logger.Printf("Device log: %s", line)
+	}
+}
+
+// EncodeFrame prefixes a ToRadio payload with the stream protocol header
+func EncodeFrame(payload []byte) ([]byte, error) {
+	if len(payload) > MAX_TO_FROM_RADIO_SIZE {
+		return nil, fmt.Errorf("ToRadio payload of %d bytes exceeds maximum of %d", len(payload), MAX_TO_FROM_RADIO_SIZE)
+	}
+
+	frame := make([]byte, 0, HEADER_LEN+len(payload))
+	frame = append(frame, START1, START2, byte(len(payload)>>8), byte(len(payload)))
+	return append(frame, payload...), nil
+}
+
+// WakeUpSequence returns the START2 bytes sent before the first frame so a
+// sleeping device starts listening, as the Python CLI does
+func WakeUpSequence() []byte {
+	wakeUp := make([]byte, WAKE_UP_LEN)
+	for i := range wakeUp {
+		wakeUp[i] = START2
+	}
+	return wakeUp
+}
+
+// EncodeWantConfig returns a marshalled ToRadio{want_config_id} message,
+// which asks the device to dump its config and start streaming packets
+func EncodeWantConfig(configID uint32) ([]byte, error) {
+	toRadio := &pb.ToRadio{
+		PayloadVariant: &pb.ToRadio_WantConfigId{WantConfigId: configID},
+	}
+	data, err := proto.Marshal(toRadio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ToRadio: %w", err)
+	}
+	return data, nil
+}
+
+// EncodeHeartbeat returns a marshalled ToRadio{heartbeat} message, which
+// keeps the device from dropping an idle API client
+func EncodeHeartbeat(nonce uint32) ([]byte, error) {
+	toRadio := &pb.ToRadio{
+		PayloadVariant: &pb.ToRadio_Heartbeat{Heartbeat: &pb.Heartbeat{Nonce: nonce}},
+	}
+	data, err := proto.Marshal(toRadio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ToRadio: %w", err)
+	}
+	return data, nil
+}
+
+// StreamSender writes ToRadio frames to a stream transport and implements
+// PacketSender for it. Connections embed it and supply their raw write function.
+type StreamSender struct {
+	write          func([]byte) error
+	logger         *log.Logger
+	mu             sync.Mutex
+	nextPacketID   uint32
+	heartbeatNonce uint32
+}
+
+// NewStreamSender creates a sender that writes frames using write
+func NewStreamSender(write func([]byte) error, logger *log.Logger) *StreamSender {
+	return &StreamSender{
+		write:  write,
+		logger: logger,
+		// Start from a random ID so packets from separate sessions don't collide
+		nextPacketID: rand.Uint32(),
+	}
+}
+
+// WriteWakeUp writes the wake-up sequence to a newly opened port or
+// connection, before it is handed to the StreamSender
+func WriteWakeUp(w io.Writer, logger *log.Logger) error {
+	logger.Printf("Sending wake-up sequence (%d x START2 bytes)...", WAKE_UP_LEN)
+	_, err := w.Write(WakeUpSequence())
+	return err
+}
+
+// StartConfig sends ToRadio{want_config_id} like Python CLI _startConfig()
+func (s *StreamSender) StartConfig(configID uint32) error {
+	s.logger.Printf("Sending configuration request (ToRadio with want_config_id=%d)", configID)
+
+	toRadioBytes, err := EncodeWantConfig(configID)
+	if err != nil {
+		return err
+	}
+	return s.SendToRadio(toRadioBytes)
+}
+
+// SendHeartbeat sends ToRadio{heartbeat} with the next nonce
+func (s *StreamSender) SendHeartbeat() error {
+	s.mu.Lock()
+	s.heartbeatNonce++
+	nonce := s.heartbeatNonce
+	s.mu.Unlock()
+
+	toRadioBytes, err := EncodeHeartbeat(nonce)
+	if err != nil {
+		return err
+	}
+	return s.SendToRadio(toRadioBytes)
+}
+
+// SendToRadio frames a marshalled ToRadio message and writes it to the stream
+func (s *StreamSender) SendToRadio(toRadioBytes []byte) error {
+	frame, err := EncodeFrame(toRadioBytes)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logger.Printf("Sending ToRadio frame: %X", frame)
+	return s.write(frame)
+}
+
+// SendData sends a payload for the given portnum as a ToRadio{packet} frame
+// and returns the ID assigned to the MeshPacket
+func (s *StreamSender) SendData(portnum pb.PortNum, payload []byte, opts SendOptions) (uint32, error) {
+	packetID := s.allocPacketID()
+	toRadioBytes, err := EncodeDataPacket(packetID, portnum, payload, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	s.logger.Printf("Sending %s packet %08x to !%08x on channel %d (hop_limit=%d, want_ack=%t)",
+		portnum, packetID, opts.To, opts.Channel, opts.HopLimit, opts.WantAck)
+
+	if err := s.SendToRadio(toRadioBytes); err != nil {
+		return 0, fmt.Errorf("failed to send packet: %w", err)
+	}
+	return packetID, nil
+}
+
+// allocPacketID returns the next non-zero packet ID
+func (s *StreamSender) allocPacketID() uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextPacketID++
+	if s.nextPacketID == 0 {
+		s.nextPacketID++
+	}
+	return s.nextPacketID
+}
+
+// EncodeDataPacket returns a marshalled ToRadio{packet} message carrying the
+// payload for the given portnum
+func EncodeDataPacket(packetID uint32, portnum pb.PortNum, payload []byte, opts SendOptions) ([]byte, error) {
+	if len(payload) > int(pb.Constants_DATA_PAYLOAD_LEN) {
+		return nil, fmt.Errorf("payload of %d bytes exceeds maximum of %d", len(payload), pb.Constants_DATA_PAYLOAD_LEN)
+	}
+
+	toRadio := &pb.ToRadio{
+		PayloadVariant: &pb.ToRadio_Packet{Packet: &pb.MeshPacket{
+			To:           opts.To,
+			Channel:      opts.Channel,
+			Id:           packetID,
+			HopLimit:     opts.HopLimit,
+			WantAck:      opts.WantAck,
+			PkiEncrypted: opts.PKIEncrypted,
+			PayloadVariant: &pb.MeshPacket_Decoded{
+				Decoded: &pb.Data{
+					Portnum:      portnum,
+					Payload:      payload,
+					WantResponse: opts.WantResponse,
+				},
+			},
+		}},
+	}
+	data, err := proto.Marshal(toRadio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ToRadio: %w", err)
+	}
+	return data, nil
+}
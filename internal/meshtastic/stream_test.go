@@ -0,0 +1,155 @@
+package meshtastic
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"testing"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// streamCapture collects what a StreamFramer emits
+type streamCapture struct {
+	frames [][]byte
+	lines  []string
+}
+
+func newCaptureFramer(capture *streamCapture) *StreamFramer {
+	return NewStreamFramer(func(payload []byte) error {
+		capture.frames = append(capture.frames, payload)
+		return nil
+	}, func(line string) {
+		capture.lines = append(capture.lines, line)
+	}, log.New(io.Discard, "", 0))
+}
+
+func mustEncodeFrame(t *testing.T, payload []byte) []byte {
+	t.Helper()
+	frame, err := EncodeFrame(payload)
+	if err != nil {
+		t.Fatalf("EncodeFrame failed: %v", err)
+	}
+	return frame
+}
+
+// Test that frames and debug log lines are separated, even when split across reads
+func TestStreamFramerInterleavedLog(t *testing.T) {
+	capture := &streamCapture{}
+	framer := newCaptureFramer(capture)
+
+	var stream []byte
+	stream = append(stream, "INFO  | 12:00:01 [Router] Booting\r\n"...)
+	stream = append(stream, mustEncodeFrame(t, []byte{0x01, 0x02, 0x03})...)
+	stream = append(stream, "DEBUG | 12:00:02 [Power] Battery ok\n"...)
+	stream = append(stream, mustEncodeFrame(t, bytes.Repeat([]byte{0xAA}, 300))...)
+
+	// Feed in small uneven chunks like a serial port would
+	for i := 0; i < len(stream); i += 7 {
+		end := min(i+7, len(stream))
+		framer.Write(stream[i:end])
+	}
+
+	if len(capture.frames) != 2 {
+		t.Fatalf("Expected 2 frames, got %d", len(capture.frames))
+	}
+	if !bytes.Equal(capture.frames[0], []byte{0x01, 0x02, 0x03}) || len(capture.frames[1]) != 300 {
+		t.Errorf("Unexpected frame contents: %X / %d bytes", capture.frames[0], len(capture.frames[1]))
+	}
+	if len(capture.lines) != 2 || capture.lines[0] != "INFO  | 12:00:01 [Router] Booting" {
+		t.Errorf("Unexpected log lines: %q", capture.lines)
+	}
+}
+
+// Test that a header with an oversized length is dropped and framing resyncs
+func TestStreamFramerOversizedFrame(t *testing.T) {
+	capture := &streamCapture{}
+	framer := newCaptureFramer(capture)
+
+	framer.Write([]byte{START1, START2, 0x7F, 0xFF})
+	framer.Write(mustEncodeFrame(t, []byte{0x42}))
+
+	if len(capture.frames) != 1 || !bytes.Equal(capture.frames[0], []byte{0x42}) {
+		t.Errorf("Expected single frame 42 after resync, got %X", capture.frames)
+	}
+}
+
+// Test that StreamSender writes frames the framer can decode back into ToRadio
+func TestStreamSenderSendData(t *testing.T) {
+	var written bytes.Buffer
+	sender := NewStreamSender(func(data []byte) error {
+		written.Write(data)
+		return nil
+	}, log.New(io.Discard, "", 0))
+
+	opts := DefaultSendOptions()
+	opts.To = 0x11223344
+	opts.WantAck = true
+	packetID, err := sender.SendData(pb.PortNum_TEXT_MESSAGE_APP, []byte("ping"), opts)
+	if err != nil {
+		t.Fatalf("SendData failed: %v", err)
+	}
+	if packetID == 0 {
+		t.Error("Expected non-zero packet ID")
+	}
+
+	capture := &streamCapture{}
+	newCaptureFramer(capture).Write(written.Bytes())
+	if len(capture.frames) != 1 {
+		t.Fatalf("Expected 1 frame, got %d", len(capture.frames))
+	}
+
+	toRadio := &pb.ToRadio{}
+	if err := proto.Unmarshal(capture.frames[0], toRadio); err != nil {
+		t.Fatalf("failed to unmarshal ToRadio: %v", err)
+	}
+	packet := toRadio.GetPacket()
+	if packet.GetId() != packetID || packet.GetTo() != 0x11223344 || !packet.GetWantAck() {
+		t.Errorf("Unexpected MeshPacket: %v", packet)
+	}
+	if packet.GetDecoded().GetPortnum() != pb.PortNum_TEXT_MESSAGE_APP || string(packet.GetDecoded().GetPayload()) != "ping" {
+		t.Errorf("Unexpected Data: %v", packet.GetDecoded())
+	}
+}
+
+// Test that heartbeats are framed ToRadio{heartbeat} messages with increasing nonces
+func TestStreamSenderHeartbeat(t *testing.T) {
+	var written bytes.Buffer
+	sender := NewStreamSender(func(data []byte) error {
+		written.Write(data)
+		return nil
+	}, log.New(io.Discard, "", 0))
+
+	for i := 0; i < 2; i++ {
+		if err := sender.SendHeartbeat(); err != nil {
+			t.Fatalf("SendHeartbeat failed: %v", err)
+		}
+	}
+
+	capture := &streamCapture{}
+	newCaptureFramer(capture).Write(written.Bytes())
+	if len(capture.frames) != 2 {
+		t.Fatalf("Expected 2 frames, got %d", len(capture.frames))
+	}
+	for i, frame := range capture.frames {
+		toRadio := &pb.ToRadio{}
+		if err := proto.Unmarshal(frame, toRadio); err != nil {
+			t.Fatalf("failed to unmarshal ToRadio: %v", err)
+		}
+		if toRadio.GetHeartbeat() == nil || toRadio.GetHeartbeat().GetNonce() != uint32(i+1) {
+			t.Errorf("Frame %d: expected heartbeat with nonce %d, got %v", i, i+1, toRadio)
+		}
+	}
+}
+
+// Test that the wake-up sequence is written as WAKE_UP_LEN START2 bytes
+func TestWriteWakeUp(t *testing.T) {
+	var written bytes.Buffer
+	if err := WriteWakeUp(&written, log.New(io.Discard, "", 0)); err != nil {
+		t.Fatalf("WriteWakeUp failed: %v", err)
+	}
+	if !bytes.Equal(written.Bytes(), bytes.Repeat([]byte{START2}, WAKE_UP_LEN)) {
+		t.Errorf("Unexpected wake-up sequence %X", written.Bytes())
+	}
+}
@@ -0,0 +1,189 @@
+package meshtastic
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LinkSource is how a link between two nodes was observed
+type LinkSource int
+
+const (
+	SourceNeighborInfo LinkSource = iota // The receiving node listed the sender in its NeighborInfo
+	SourceTraceroute                     // Consecutive hops of a traceroute reply
+	SourceHeard                          // Our node heard the sender without relays
+)
+
+var linkSourceNames = map[LinkSource]string{
+	SourceNeighborInfo: "neighborinfo",
+	SourceTraceroute:   "traceroute",
+	SourceHeard:        "heard",
+}
+
+func (s LinkSource) String() string {
+	if name, ok := linkSourceNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("LinkSource(%d)", int(s))
+}
+
+// TopologyLink is a directed link of the mesh: To has heard From
+type TopologyLink struct {
+	From     uint32     `json:"from"`
+	To       uint32     `json:"to"`
+	SNR      float32    `json:"snr"` // dB as measured by To, valid if SNRKnown
+	SNRKnown bool       `json:"snr_known"`
+	Source   LinkSource `json:"source"`
+	LastSeen time.Time  `json:"last_seen"`
+}
+
+// topologyKey identifies a directed link
+type topologyKey struct {
+	from, to uint32
+}
+
+// Topology is the graph of who can hear whom, built from NeighborInfo
+// packets, traceroute replies and packets our node heard directly. Each
+// link keeps its latest observation.
+type Topology struct {
+	mu    sync.RWMutex
+	links map[topologyKey]TopologyLink
+}
+
+// NewTopology creates an empty topology graph
+func NewTopology() *Topology {
+	return &Topology{links: make(map[topologyKey]TopologyLink)}
+}
+
+// add stores a link. Callers hold t.mu.
+func (t *Topology) add(link TopologyLink) {
+	if link.From == 0 || link.To == 0 || link.From == link.To ||
+		link.From == BroadcastAddr || link.To == BroadcastAddr {
+		return
+	}
+	key := topologyKey{link.From, link.To}
+	if old, ok := t.links[key]; ok && old.LastSeen.After(link.LastSeen) {
+		return
+	}
+	t.links[key] = link
+}
+
+// AddNeighborInfo records the neighbors node reported. The report replaces
+// the node's previous one, so neighbors it no longer lists are dropped.
+func (t *Topology) AddNeighborInfo(node uint32, neighbors []*Neighbor, rxTime time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, link := range t.links {
+		if key.to == node && link.Source == SourceNeighborInfo {
+			delete(t.links, key)
+		}
+	}
+	for _, neighbor := range neighbors {
+		t.add(TopologyLink{
+			From:     neighbor.GetNodeId(),
+			To:       node,
+			SNR:      neighbor.GetSnr(),
+			SNRKnown: true,
+			Source:   SourceNeighborInfo,
+			LastSeen: rxTime,
+		})
+	}
+}
+
+// AddHops records the hops of a traced path
+func (t *Topology) AddHops(hops []TracerouteHop, rxTime time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, hop := range hops {
+		t.add(TopologyLink{
+			From:     hop.From,
+			To:       hop.To,
+			SNR:      hop.SNR,
+			SNRKnown: hop.SNRKnown,
+			Source:   SourceTraceroute,
+			LastSeen: rxTime,
+		})
+	}
+}
+
+// AddHeard records that to received a packet from from without relays
+func (t *Topology) AddHeard(from, to uint32, snr float32, rxTime time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.add(TopologyLink{From: from, To: to, SNR: snr, SNRKnown: true, Source: SourceHeard, LastSeen: rxTime})
+}
+
+// Links returns every link, ordered by sender and receiver
+func (t *Topology) Links() []TopologyLink {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	links := make([]TopologyLink, 0, len(t.links))
+	for _, link := range t.links {
+		links = append(links, link)
+	}
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].From != links[j].From {
+			return links[i].From < links[j].From
+		}
+		return links[i].To < links[j].To
+	})
+	return links
+}
+
+// Nodes returns every node with at least one link, in ascending order
+func (t *Topology) Nodes() []uint32 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	seen := make(map[uint32]bool)
+	for key := range t.links {
+		seen[key.from] = true
+		seen[key.to] = true
+	}
+	nodes := make([]uint32, 0, len(seen))
+	for node := range seen {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return nodes
+}
+
+// updateTopology adds the links a packet reveals to the topology graph
+func (c *Client) updateTopology(packet *Packet) {
+	switch d := packet.DecodedData.(type) {
+	case *NeighborInfo:
+		node := d.GetNodeId()
+		if node == 0 {
+			node = packet.From
+		}
+		c.topology.AddNeighborInfo(node, d.GetNeighbors(), packet.RxTime)
+
+	case *RouteInfo:
+		// Only a reply carries the whole path towards the destination; the
+		// path back is known as far as the hops that added their SNR
+		if packet.RequestID == 0 {
+			break
+		}
+		c.topology.AddHops(buildHops(packet.To, d.Route, packet.From, d.SNRTowards), packet.RxTime)
+		if len(d.SNRBack) > 0 {
+			back := buildHops(packet.From, d.RouteBack, packet.To, d.SNRBack)
+			c.topology.AddHops(back[:min(len(d.SNRBack), len(back))], packet.RxTime)
+		}
+	}
+
+	// A packet that used none of its hops was heard directly by our node
+	if packet.FromMesh && !packet.Sent && packet.HopStart != 0 && packet.HopCount == 0 {
+		if me := c.GetMyNodeNum(); me != 0 && packet.From != me {
+			c.topology.AddHeard(packet.From, me, packet.RxSNR, packet.RxTime)
+		}
+	}
+}
+
+// GetTopology returns the graph of who can hear whom
+func (c *Client) GetTopology() *Topology {
+	return c.topology
+}
@@ -0,0 +1,167 @@
+package meshtastic
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// dotStyles draws links by how they were observed
+var dotStyles = map[LinkSource]string{
+	SourceNeighborInfo: "solid",
+	SourceTraceroute:   "dashed",
+	SourceHeard:        "bold",
+}
+
+// dotQuote quotes s as a DOT string
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + strings.ReplaceAll(s, "\n", `\n`) + `"`
+}
+
+// nodeKey is the ID a node is written under in exported graphs
+func nodeKey(node uint32) string {
+	return fmt.Sprintf("!%08x", node)
+}
+
+// WriteDOT writes the graph in Graphviz DOT format, naming nodes from nodeDB.
+// An edge A -> B means B has heard A.
+func (t *Topology) WriteDOT(w io.Writer, nodeDB *NodeDB) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph mesh {")
+	fmt.Fprintln(bw, "\tnode [shape=box];")
+
+	for _, node := range t.Nodes() {
+		label := nodeDB.GetNodeName(node) + "\n" + nodeKey(node)
+		fmt.Fprintf(bw, "\t%s [label=%s];\n", dotQuote(nodeKey(node)), dotQuote(label))
+	}
+	for _, link := range t.Links() {
+		label := "?"
+		if link.SNRKnown {
+			label = fmt.Sprintf("%.2f dB", link.SNR)
+		}
+		fmt.Fprintf(bw, "\t%s -> %s [label=%s, style=%s, tooltip=%s];\n",
+			dotQuote(nodeKey(link.From)), dotQuote(nodeKey(link.To)), dotQuote(label),
+			dotStyles[link.Source], dotQuote(link.Source.String()))
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// GraphML document structure, see http://graphml.graphdrawing.org/
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// WriteGraphML writes the graph in GraphML format, naming nodes from nodeDB.
+// An edge from A to B means B has heard A.
+func (t *Topology) WriteGraphML(w io.Writer, nodeDB *NodeDB) error {
+	doc := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "name", For: "node", Name: "name", Type: "string"},
+			{ID: "short_name", For: "node", Name: "short_name", Type: "string"},
+			{ID: "snr", For: "edge", Name: "snr", Type: "double"},
+			{ID: "source", For: "edge", Name: "source", Type: "string"},
+			{ID: "last_seen", For: "edge", Name: "last_seen", Type: "string"},
+		},
+		Graph: graphMLGraph{ID: "mesh", EdgeDefault: "directed"},
+	}
+
+	for _, node := range t.Nodes() {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID: nodeKey(node),
+			Data: []graphMLData{
+				{Key: "name", Value: nodeDB.GetNodeName(node)},
+				{Key: "short_name", Value: nodeDB.GetNodeShortName(node)},
+			},
+		})
+	}
+	for _, link := range t.Links() {
+		var data []graphMLData
+		if link.SNRKnown {
+			data = append(data, graphMLData{Key: "snr", Value: fmt.Sprintf("%g", link.SNR)})
+		}
+		data = append(data,
+			graphMLData{Key: "source", Value: link.Source.String()},
+			graphMLData{Key: "last_seen", Value: link.LastSeen.Format(time.RFC3339)},
+		)
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			Source: nodeKey(link.From),
+			Target: nodeKey(link.To),
+			Data:   data,
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode GraphML: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// Save writes the graph to path as GraphML if it ends in .graphml, DOT otherwise
+func (t *Topology) Save(path string, nodeDB *NodeDB) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".graphml") {
+		err = t.WriteGraphML(f, nodeDB)
+	} else {
+		err = t.WriteDOT(f, nodeDB)
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package meshtastic
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+)
+
+// newTopologyTestClient returns a client with links from a NeighborInfo
+// report, a traceroute reply and a packet heard directly
+func newTopologyTestClient(t *testing.T) *Client {
+	t.Helper()
+	client := newTestClient(t)
+	client.myNodeNum = 0x1000
+	now := time.Now()
+
+	info := &pb.NeighborInfo{
+		NodeId:                    0x2000,
+		NodeBroadcastIntervalSecs: 900,
+		Neighbors: []*pb.Neighbor{
+			{NodeId: 0x3000, Snr: 6.5},
+			{NodeId: 0x4000, Snr: -2.25},
+		},
+	}
+	decoded := decodePayload(PacketTypeNeighborInfo, mustMarshal(t, info))
+	if _, ok := decoded.(*NeighborInfo); !ok {
+		t.Fatalf("Expected NeighborInfo, got %T", decoded)
+	}
+	client.updateTopology(&Packet{From: 0x2000, To: BroadcastAddr, Type: PacketTypeNeighborInfo, RxTime: now, DecodedData: decoded})
+
+	// Reply from 0x3000 to our traceroute, via 0x2000 both ways; the last
+	// hop back hasn't added its SNR
+	client.updateTopology(&Packet{From: 0x3000, To: 0x1000, RequestID: 1, RxTime: now, DecodedData: &RouteInfo{
+		Route:      []uint32{0x2000},
+		SNRTowards: []int32{40, 24},
+		RouteBack:  []uint32{0x2000},
+		SNRBack:    []int32{unknownSNR},
+	}})
+
+	client.updateTopology(&Packet{From: 0x2000, To: BroadcastAddr, FromMesh: true, HopStart: 3, RxSNR: 9.75, RxTime: now})
+	return client
+}
+
+// Test that NeighborInfo, traceroute hops and direct receptions become links
+func TestTopology(t *testing.T) {
+	client := newTopologyTestClient(t)
+	topology := client.GetTopology()
+
+	want := []struct {
+		from, to uint32
+		snr      float32
+		known    bool
+		source   LinkSource
+	}{
+		{0x1000, 0x2000, 10, true, SourceTraceroute},
+		{0x2000, 0x1000, 9.75, true, SourceHeard},
+		{0x2000, 0x3000, 6, true, SourceTraceroute},
+		{0x3000, 0x2000, 0, false, SourceTraceroute},
+		{0x4000, 0x2000, -2.25, true, SourceNeighborInfo},
+	}
+	links := topology.Links()
+	if len(links) != len(want) {
+		t.Fatalf("Expected %d links, got %+v", len(want), links)
+	}
+	for i, w := range want {
+		l := links[i]
+		if l.From != w.from || l.To != w.to || l.SNR != w.snr || l.SNRKnown != w.known || l.Source != w.source {
+			t.Errorf("Link %d: expected %+v, got %+v", i, w, l)
+		}
+	}
+
+	// A new report replaces the node's neighbor list
+	topology.AddNeighborInfo(0x2000, []*Neighbor{{NodeId: 0x5000, Snr: 1}}, time.Now())
+	for _, link := range topology.Links() {
+		if link.From == 0x4000 {
+			t.Errorf("Expected the dropped neighbor's link to be removed, got %+v", link)
+		}
+	}
+	if nodes := topology.Nodes(); len(nodes) != 4 {
+		t.Errorf("Expected 4 nodes, got %v", nodes)
+	}
+}
+
+// Test the DOT and GraphML exports
+func TestTopologyExport(t *testing.T) {
+	client := newTopologyTestClient(t)
+	client.nodeDB.AddOrUpdateUserInfo(0x2000, "!00002000", `Relay "North"`, "RLYN")
+
+	var dot strings.Builder
+	if err := client.GetTopology().WriteDOT(&dot, client.GetNodeDB()); err != nil {
+		t.Fatalf("WriteDOT failed: %v", err)
+	}
+	for _, line := range []string{
+		`"!00002000" [label="Relay \"North\"\n!00002000"];`,
+		`"!00004000" -> "!00002000" [label="-2.25 dB", style=solid, tooltip="neighborinfo"];`,
+		`"!00003000" -> "!00002000" [label="?", style=dashed, tooltip="traceroute"];`,
+	} {
+		if !strings.Contains(dot.String(), line) {
+			t.Errorf("Expected DOT output to contain %s, got:\n%s", line, dot.String())
+		}
+	}
+
+	var graphml strings.Builder
+	if err := client.GetTopology().WriteGraphML(&graphml, client.GetNodeDB()); err != nil {
+		t.Fatalf("WriteGraphML failed: %v", err)
+	}
+	var doc graphML
+	if err := xml.Unmarshal([]byte(graphml.String()), &doc); err != nil {
+		t.Fatalf("GraphML doesn't parse: %v", err)
+	}
+	if len(doc.Graph.Nodes) != 4 || len(doc.Graph.Edges) != 5 {
+		t.Errorf("Expected 4 nodes and 5 edges, got %d and %d", len(doc.Graph.Nodes), len(doc.Graph.Edges))
+	}
+	if doc.Graph.Nodes[1].Data[0].Value != `Relay "North"` {
+		t.Errorf("Expected the node name from the NodeDB, got %+v", doc.Graph.Nodes[1])
+	}
+}
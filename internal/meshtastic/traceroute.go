@@ -0,0 +1,142 @@
+package meshtastic
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// unknownSNR is the INT8_MIN placeholder for hops whose SNR wasn't recorded
+const unknownSNR = -128
+
+// DefaultTracerouteTimeout is how long to wait for a RouteDiscovery reply
+const DefaultTracerouteTimeout = 60 * time.Second
+
+// TracerouteHop is one link of a traced path
+type TracerouteHop struct {
+	From     uint32  `json:"from"`
+	To       uint32  `json:"to"`
+	SNR      float32 `json:"snr"` // dB as measured by To, valid if SNRKnown
+	SNRKnown bool    `json:"snr_known"`
+}
+
+// TracerouteResult is the outcome of one traceroute run
+type TracerouteResult struct {
+	Origin      uint32        `json:"origin"`
+	Destination uint32        `json:"destination"`
+	Route       *RouteInfo    `json:"route"`
+	StartTime   time.Time     `json:"start_time"`
+	Duration    time.Duration `json:"duration"`
+}
+
+// ForwardHops returns the path from the origin to the destination
+func (r *TracerouteResult) ForwardHops() []TracerouteHop {
+	return buildHops(r.Origin, r.Route.Route, r.Destination, r.Route.SNRTowards)
+}
+
+// ReturnHops returns the path the reply took back, or nil if the
+// destination's firmware doesn't record it
+func (r *TracerouteResult) ReturnHops() []TracerouteHop {
+	if len(r.Route.RouteBack) == 0 && len(r.Route.SNRBack) == 0 {
+		return nil
+	}
+	return buildHops(r.Destination, r.Route.RouteBack, r.Origin, r.Route.SNRBack)
+}
+
+// Format renders both paths using node names from the NodeDB
+func (r *TracerouteResult) Format(nodeDB *NodeDB) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Route towards %s (%s):\n  %s\n",
+		nodeDB.GetNodeName(r.Destination), r.StartTime.Format("15:04:05"), formatHops(r.ForwardHops(), nodeDB))
+
+	if hops := r.ReturnHops(); hops != nil {
+		fmt.Fprintf(&b, "Route back to us:\n  %s\n", formatHops(hops, nodeDB))
+	} else {
+		b.WriteString("Route back to us: not reported by destination\n")
+	}
+
+	fmt.Fprintf(&b, "Round trip: %s", r.Duration.Truncate(time.Millisecond))
+	return b.String()
+}
+
+// Traceroute sends a RouteDiscovery request to dest and waits for the reply
+func (c *Client) Traceroute(dest uint32, hopLimit uint32, timeout time.Duration) (*TracerouteResult, error) {
+	payload, err := proto.Marshal(&pb.RouteDiscovery{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal RouteDiscovery: %w", err)
+	}
+
+	opts := DefaultSendOptions()
+	opts.To = dest
+	opts.HopLimit = hopLimit
+	opts.WantResponse = true
+
+	startTime := time.Now()
+	responses, cancel, err := c.sendAndAwaitResponses(pb.PortNum_TRACEROUTE_APP, payload, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send traceroute: %w", err)
+	}
+	defer cancel()
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case packet := <-responses:
+			switch d := packet.DecodedData.(type) {
+			case *RouteInfo:
+				return &TracerouteResult{
+					Origin:      packet.To,
+					Destination: dest,
+					Route:       d,
+					StartTime:   startTime,
+					Duration:    time.Since(startTime),
+				}, nil
+			case *Routing:
+				if reason := d.GetErrorReason(); reason != pb.Routing_NONE {
+					return nil, fmt.Errorf("traceroute to !%08x failed: %s", dest, reason)
+				}
+			}
+
+		case <-deadline.C:
+			return nil, fmt.Errorf("traceroute to !%08x timed out after %s", dest, timeout)
+		}
+	}
+}
+
+// buildHops pairs each node on a path with the SNR its successor measured
+func buildHops(start uint32, relays []uint32, end uint32, snrs []int32) []TracerouteHop {
+	nodes := append(append([]uint32{start}, relays...), end)
+
+	hops := make([]TracerouteHop, 0, len(nodes)-1)
+	for i := 0; i < len(nodes)-1; i++ {
+		hop := TracerouteHop{From: nodes[i], To: nodes[i+1]}
+		if i < len(snrs) && snrs[i] != unknownSNR {
+			hop.SNR = float32(snrs[i]) / 4
+			hop.SNRKnown = true
+		}
+		hops = append(hops, hop)
+	}
+	return hops
+}
+
+// formatHops renders a path like the Python CLI: "A --> B (6.25dB) --> C (?dB)"
+func formatHops(hops []TracerouteHop, nodeDB *NodeDB) string {
+	if len(hops) == 0 {
+		return ""
+	}
+
+	parts := []string{nodeDB.GetNodeName(hops[0].From)}
+	for _, hop := range hops {
+		snr := "?"
+		if hop.SNRKnown {
+			snr = fmt.Sprintf("%.2f", hop.SNR)
+		}
+		parts = append(parts, fmt.Sprintf("%s (%sdB)", nodeDB.GetNodeName(hop.To), snr))
+	}
+	return strings.Join(parts, " --> ")
+}
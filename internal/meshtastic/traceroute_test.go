@@ -0,0 +1,116 @@
+package meshtastic
+
+import (
+	"io"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+)
+
+// fakeSender is a connection that records sent packets instead of transmitting them
+type fakeSender struct {
+	*StreamSender
+	sent chan uint32
+}
+
+func newFakeSender() *fakeSender {
+	f := &fakeSender{sent: make(chan uint32, 4)}
+	f.StreamSender = NewStreamSender(func([]byte) error { return nil }, log.New(io.Discard, "", 0))
+	return f
+}
+
+func (f *fakeSender) Connect() error                                       { return nil }
+func (f *fakeSender) Close() error                                         { return nil }
+func (f *fakeSender) IsConnected() bool                                    { return true }
+func (f *fakeSender) GetConnectionInfo() string                            { return "fake" }
+func (f *fakeSender) StartPacketListener(handler func([]byte) error) error { return nil }
+func (f *fakeSender) SendCommand(command string) error                     { return nil }
+
+func (f *fakeSender) SendData(portnum pb.PortNum, payload []byte, opts SendOptions) (uint32, error) {
+	packetID, err := f.StreamSender.SendData(portnum, payload, opts)
+	f.sent <- packetID
+	return packetID, err
+}
+
+// Test that hops pair nodes with SNRs and unknown SNRs are rendered as "?"
+func TestTracerouteResultFormat(t *testing.T) {
+	nodeDB := NewNodeDB()
+	nodeDB.AddOrUpdateUserInfo(0x1000, "!00001000", "Base", "BASE")
+	nodeDB.AddOrUpdateUserInfo(0x2000, "!00002000", "Hilltop Relay", "HTR")
+
+	result := &TracerouteResult{
+		Origin:      0x1000,
+		Destination: 0x3000,
+		Route: &RouteInfo{
+			Route:      []uint32{0x2000},
+			SNRTowards: []int32{25, unknownSNR},
+			SNRBack:    []int32{-10, 8},
+		},
+	}
+
+	forward := result.ForwardHops()
+	if len(forward) != 2 || forward[0].To != 0x2000 || forward[0].SNR != 6.25 || forward[1].SNRKnown {
+		t.Errorf("Unexpected forward hops: %+v", forward)
+	}
+	back := result.ReturnHops()
+	if len(back) != 1 || back[0].From != 0x3000 || back[0].To != 0x1000 || back[0].SNR != -2.5 {
+		t.Errorf("Unexpected return hops: %+v", back)
+	}
+
+	output := result.Format(nodeDB)
+	if !strings.Contains(output, "Base --> Hilltop Relay (6.25dB) --> !00003000 (?dB)") {
+		t.Errorf("Unexpected forward path rendering:\n%s", output)
+	}
+	if !strings.Contains(output, "!00003000 --> Base (-2.50dB)") {
+		t.Errorf("Unexpected return path rendering:\n%s", output)
+	}
+}
+
+// Test that Traceroute returns the decoded reply matched by request_id
+func TestClientTraceroute(t *testing.T) {
+	sender := newFakeSender()
+	client, err := NewClient(sender, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	go func() {
+		packetID := <-sender.sent
+		client.dispatchResponse(&Packet{From: 0x3000, To: 0x1000, RequestID: packetID + 1, DecodedData: &RouteInfo{}})
+		client.dispatchResponse(&Packet{
+			From:        0x3000,
+			To:          0x1000,
+			RequestID:   packetID,
+			DecodedData: &RouteInfo{Route: []uint32{0x2000}, SNRTowards: []int32{12, 20}},
+		})
+	}()
+
+	result, err := client.Traceroute(0x3000, DefaultHopLimit, time.Second)
+	if err != nil {
+		t.Fatalf("Traceroute failed: %v", err)
+	}
+	if result.Origin != 0x1000 || len(result.Route.Route) != 1 {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
+
+// Test that a nak for the request fails the traceroute and silence times out
+func TestClientTracerouteFailure(t *testing.T) {
+	sender := newFakeSender()
+	client, _ := NewClient(sender, log.New(io.Discard, "", 0))
+
+	go func() {
+		packetID := <-sender.sent
+		client.dispatchResponse(&Packet{RequestID: packetID, DecodedData: routingError(pb.Routing_NO_RESPONSE)})
+	}()
+	if _, err := client.Traceroute(0x3000, DefaultHopLimit, time.Second); err == nil || !strings.Contains(err.Error(), "NO_RESPONSE") {
+		t.Errorf("Expected NO_RESPONSE error, got %v", err)
+	}
+
+	if _, err := client.Traceroute(0x3000, DefaultHopLimit, 10*time.Millisecond); err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Expected timeout error, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package meshtastic
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultTrackLength is how many positions are kept per node
+const DefaultTrackLength = 200
+
+// TrackPoint is a position reported by a node
+type TrackPoint struct {
+	Latitude  float64   `json:"latitude"`
+	Longitude float64   `json:"longitude"`
+	Time      time.Time `json:"time"`
+}
+
+// PositionHistory keeps the positions each node reported this session, so
+// their movement can be drawn as tracks. It is safe for concurrent use.
+type PositionHistory struct {
+	mu     sync.RWMutex
+	limit  int
+	tracks map[uint32][]TrackPoint
+}
+
+// NewPositionHistory creates a history keeping up to limit positions per node
+func NewPositionHistory(limit int) *PositionHistory {
+	return &PositionHistory{limit: limit, tracks: make(map[uint32][]TrackPoint)}
+}
+
+// Add records a node's position. Positions without coordinates, and ones
+// that repeat the node's last position, are skipped.
+func (h *PositionHistory) Add(node uint32, position *Position, rxTime time.Time) {
+	if position.LatitudeI == nil || position.LongitudeI == nil ||
+		(position.GetLatitudeI() == 0 && position.GetLongitudeI() == 0) {
+		return
+	}
+	point := TrackPoint{
+		Latitude:  GetLatitudeDegrees(position),
+		Longitude: GetLongitudeDegrees(position),
+		Time:      rxTime,
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	track := h.tracks[node]
+	if n := len(track); n > 0 && track[n-1].Latitude == point.Latitude && track[n-1].Longitude == point.Longitude {
+		return
+	}
+	track = append(track, point)
+	if len(track) > h.limit {
+		track = track[len(track)-h.limit:]
+	}
+	h.tracks[node] = track
+}
+
+// Track returns the positions a node reported, oldest first
+func (h *PositionHistory) Track(node uint32) []TrackPoint {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return append([]TrackPoint(nil), h.tracks[node]...)
+}
+
+// GetPositionHistory returns the positions nodes reported this session
+func (c *Client) GetPositionHistory() *PositionHistory {
+	return c.tracks
+}
@@ -0,0 +1,236 @@
+package meshtastic
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// Longest waypoint name and description the firmware accepts, in bytes.
+// nanopb keeps one byte of the 30 and 100 byte fields for the terminator.
+const (
+	MaxWaypointNameLen        = 29
+	MaxWaypointDescriptionLen = 99
+)
+
+// DefaultWaypointIcon is shown for waypoints sent without an icon
+const DefaultWaypointIcon = '📍'
+
+// waypointDeleteExpire is the expiry apps send to delete a waypoint
+const waypointDeleteExpire = 1
+
+// WaypointRecord is the latest version of a waypoint seen on the mesh
+type WaypointRecord struct {
+	Waypoint *Waypoint `json:"waypoint"`
+	From     uint32    `json:"from"`    // Node that sent this version
+	Channel  int32     `json:"channel"` // Channel index it was received on, -1 if not one of the device's
+	Updated  time.Time `json:"updated"`
+}
+
+// Icon returns the waypoint's emoji, DefaultWaypointIcon if it has none or
+// the sender gave a control code or other non-printable rune
+func (r WaypointRecord) Icon() string {
+	if icon := rune(r.Waypoint.GetIcon()); utf8.ValidRune(icon) && unicode.IsPrint(icon) {
+		return string(icon)
+	}
+	return string(DefaultWaypointIcon)
+}
+
+// HasPosition reports whether the waypoint has coordinates
+func (r WaypointRecord) HasPosition() bool {
+	return r.Waypoint.LatitudeI != nil && r.Waypoint.LongitudeI != nil
+}
+
+// Position returns the waypoint's coordinates as a Position
+func (r WaypointRecord) Position() *Position {
+	return &Position{LatitudeI: r.Waypoint.LatitudeI, LongitudeI: r.Waypoint.LongitudeI}
+}
+
+// Expired reports whether the waypoint's expiry time has passed
+func (r WaypointRecord) Expired(now time.Time) bool {
+	return waypointExpired(r.Waypoint, now)
+}
+
+func waypointExpired(waypoint *Waypoint, now time.Time) bool {
+	expire := waypoint.GetExpire()
+	return expire != 0 && int64(expire) <= now.Unix()
+}
+
+// WaypointStore keeps the waypoints seen on the mesh by ID
+type WaypointStore struct {
+	mu        sync.RWMutex
+	waypoints map[uint32]WaypointRecord
+}
+
+// NewWaypointStore creates an empty waypoint store
+func NewWaypointStore() *WaypointStore {
+	return &WaypointStore{waypoints: make(map[uint32]WaypointRecord)}
+}
+
+// Update applies a waypoint sent by from and reports whether it was accepted.
+// A waypoint locked to a node can only be changed by that node, and one that
+// has expired is removed.
+func (s *WaypointStore) Update(waypoint *Waypoint, from uint32, channel int32, rxTime time.Time) bool {
+	id := waypoint.GetId()
+	if id == 0 {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if old, ok := s.waypoints[id]; ok {
+		if locked := old.Waypoint.GetLockedTo(); locked != 0 && locked != from {
+			return false
+		}
+	}
+	if waypointExpired(waypoint, rxTime) {
+		delete(s.waypoints, id)
+		return true
+	}
+	s.waypoints[id] = WaypointRecord{Waypoint: waypoint, From: from, Channel: channel, Updated: rxTime}
+	return true
+}
+
+// Get returns the waypoint with the given ID
+func (s *WaypointStore) Get(id uint32) (WaypointRecord, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	record, ok := s.waypoints[id]
+	return record, ok
+}
+
+// List returns the waypoints that haven't expired by now, ordered by name
+func (s *WaypointStore) List(now time.Time) []WaypointRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := make([]WaypointRecord, 0, len(s.waypoints))
+	for _, record := range s.waypoints {
+		if !record.Expired(now) {
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		a, b := records[i].Waypoint, records[j].Waypoint
+		if a.GetName() != b.GetName() {
+			return a.GetName() < b.GetName()
+		}
+		return a.GetId() < b.GetId()
+	})
+	return records
+}
+
+// NewWaypoint creates a waypoint at the given coordinates in degrees. The ID
+// is assigned when it is sent.
+func NewWaypoint(name, description string, latitude, longitude float64) *Waypoint {
+	lat := int32(math.Round(latitude * 1e7))
+	lon := int32(math.Round(longitude * 1e7))
+	return &Waypoint{
+		Name:        name,
+		Description: description,
+		LatitudeI:   &lat,
+		LongitudeI:  &lon,
+		Icon:        uint32(DefaultWaypointIcon),
+	}
+}
+
+// validateWaypoint checks a waypoint against the firmware's limits
+func validateWaypoint(waypoint *Waypoint) error {
+	switch {
+	case waypoint.GetName() == "":
+		return fmt.Errorf("waypoint needs a name")
+	case len(waypoint.GetName()) > MaxWaypointNameLen:
+		return fmt.Errorf("waypoint name is longer than %d bytes", MaxWaypointNameLen)
+	case len(waypoint.GetDescription()) > MaxWaypointDescriptionLen:
+		return fmt.Errorf("waypoint description is longer than %d bytes", MaxWaypointDescriptionLen)
+	case waypoint.LatitudeI == nil || waypoint.LongitudeI == nil:
+		return fmt.Errorf("waypoint needs a latitude and longitude")
+	case math.Abs(float64(waypoint.GetLatitudeI())/1e7) > 90 || math.Abs(float64(waypoint.GetLongitudeI())/1e7) > 180:
+		return fmt.Errorf("waypoint position %.7f,%.7f is out of range",
+			float64(waypoint.GetLatitudeI())/1e7, float64(waypoint.GetLongitudeI())/1e7)
+	}
+	return nil
+}
+
+// SendWaypoint broadcasts a waypoint on a channel and returns its ID. A
+// waypoint without an ID is given a new one; sending an existing ID updates
+// that waypoint.
+func (c *Client) SendWaypoint(waypoint *Waypoint, channel uint32) (uint32, error) {
+	if err := validateWaypoint(waypoint); err != nil {
+		return 0, err
+	}
+	if record, ok := c.waypoints.Get(waypoint.GetId()); ok {
+		if locked := record.Waypoint.GetLockedTo(); locked != 0 && locked != c.GetMyNodeNum() {
+			return 0, fmt.Errorf("waypoint %d is locked to %s", waypoint.GetId(), c.GetNodeName(locked))
+		}
+	}
+	for waypoint.Id == 0 {
+		waypoint.Id = rand.Uint32()
+	}
+
+	if err := c.sendWaypoint(waypoint, channel); err != nil {
+		return 0, err
+	}
+	return waypoint.GetId(), nil
+}
+
+// DeleteWaypoint removes a waypoint from the mesh by sending it again with
+// an expiry time in the past
+func (c *Client) DeleteWaypoint(id uint32, channel uint32) error {
+	waypoint := &Waypoint{Id: id}
+	if record, ok := c.waypoints.Get(id); ok {
+		if locked := record.Waypoint.GetLockedTo(); locked != 0 && locked != c.GetMyNodeNum() {
+			return fmt.Errorf("waypoint %d is locked to %s", id, c.GetNodeName(locked))
+		}
+		waypoint = proto.Clone(record.Waypoint).(*Waypoint)
+	}
+	waypoint.Expire = waypointDeleteExpire
+	return c.sendWaypoint(waypoint, channel)
+}
+
+// sendWaypoint broadcasts a waypoint and records it, as our own packets
+// don't come back through the packet pipeline
+func (c *Client) sendWaypoint(waypoint *Waypoint, channel uint32) error {
+	payload, err := proto.Marshal(waypoint)
+	if err != nil {
+		return fmt.Errorf("failed to marshal Waypoint: %w", err)
+	}
+
+	opts := DefaultSendOptions()
+	opts.Channel = channel
+	if _, err := c.SendData(pb.PortNum_WAYPOINT_APP, payload, opts); err != nil {
+		return err
+	}
+	c.waypoints.Update(waypoint, c.GetMyNodeNum(), int32(channel), c.Now())
+	return nil
+}
+
+// updateWaypoints records waypoints received from the mesh, with the index
+// of their channel rather than the hash locally decrypted packets carry
+func (c *Client) updateWaypoints(packet *Packet) {
+	waypoint, ok := packet.DecodedData.(*Waypoint)
+	if !ok {
+		return
+	}
+	channel := int32(-1)
+	if ref, ok := c.ResolveChannel(packet); ok {
+		channel = ref.Index
+	}
+	if !c.waypoints.Update(waypoint, packet.From, channel, packet.RxTime) {
+		c.logger.Printf("Ignoring waypoint %d from %08x", waypoint.GetId(), packet.From)
+	}
+}
+
+// GetWaypoints returns the waypoints seen on the mesh
+func (c *Client) GetWaypoints() *WaypointStore {
+	return c.waypoints
+}
@@ -0,0 +1,162 @@
+package meshtastic
+
+import (
+	"io"
+	"log"
+	"testing"
+	"time"
+
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// Test that WAYPOINT_APP payloads decode as waypoints rather than positions
+func TestDecodeWaypoint(t *testing.T) {
+	if PortNumToPacketType[uint32(pb.PortNum_WAYPOINT_APP)] != PacketTypeWaypoint {
+		t.Fatalf("Expected WAYPOINT_APP to map to %s", PacketTypeNames[PacketTypeWaypoint])
+	}
+
+	waypoint := NewWaypoint("Trailhead", "Parking lot", 47.6062095, -122.3320708)
+	waypoint.Id = 42
+	waypoint.Expire = 1700000000
+	waypoint.LockedTo = 0x1000
+
+	decoded, ok := decodePayload(PacketTypeWaypoint, mustMarshal(t, waypoint)).(*Waypoint)
+	if !ok {
+		t.Fatalf("Expected a Waypoint")
+	}
+	if !proto.Equal(decoded, waypoint) {
+		t.Errorf("Expected %v, got %v", waypoint, decoded)
+	}
+	if decoded.GetLatitudeI() != 476062095 || decoded.GetLongitudeI() != -1223320708 {
+		t.Errorf("Unexpected position %d,%d", decoded.GetLatitudeI(), decoded.GetLongitudeI())
+	}
+}
+
+// Test that waypoints without a printable icon get the default one
+func TestWaypointIcon(t *testing.T) {
+	for icon, expected := range map[uint32]string{
+		0:          string(DefaultWaypointIcon),
+		'⛺':        "⛺",
+		0x1b:       string(DefaultWaypointIcon),
+		0x7f:       string(DefaultWaypointIcon),
+		0xD800:     string(DefaultWaypointIcon),
+		0x7FFFFFFF: string(DefaultWaypointIcon),
+	} {
+		record := WaypointRecord{Waypoint: &Waypoint{Icon: icon}}
+		if got := record.Icon(); got != expected {
+			t.Errorf("Icon %#x: expected %q, got %q", icon, expected, got)
+		}
+	}
+}
+
+// Test that locked waypoints only change for their owner and expired ones are removed
+func TestWaypointStore(t *testing.T) {
+	store := NewWaypointStore()
+	now := time.Unix(1700000000, 0)
+
+	open := &Waypoint{Id: 1, Name: "Summit"}
+	locked := &Waypoint{Id: 2, Name: "Camp", LockedTo: 0x2000}
+	store.Update(open, 0x2000, 0, now)
+	store.Update(locked, 0x2000, 1, now)
+
+	if store.Update(&Waypoint{Id: 2, Name: "Moved"}, 0x3000, 0, now) {
+		t.Errorf("Expected an update of a locked waypoint by another node to be rejected")
+	}
+	if !store.Update(&Waypoint{Id: 1, Name: "Peak"}, 0x3000, 0, now) {
+		t.Errorf("Expected an update of an open waypoint to be accepted")
+	}
+	if record, _ := store.Get(1); record.Waypoint.GetName() != "Peak" || record.From != 0x3000 {
+		t.Errorf("Expected the updated waypoint, got %+v", record)
+	}
+
+	list := store.List(now)
+	if len(list) != 2 || list[0].Waypoint.GetName() != "Camp" || list[1].Waypoint.GetName() != "Peak" {
+		t.Errorf("Expected waypoints ordered by name, got %+v", list)
+	}
+
+	// Deleting sends the waypoint with an expiry in the past
+	if !store.Update(&Waypoint{Id: 2, Expire: waypointDeleteExpire}, 0x2000, 1, now) {
+		t.Errorf("Expected the owner to delete its waypoint")
+	}
+	if _, ok := store.Get(2); ok {
+		t.Errorf("Expected the deleted waypoint to be removed")
+	}
+
+	// Waypoints drop out of the list once they expire
+	store.Update(&Waypoint{Id: 3, Name: "Meetup", Expire: uint32(now.Add(time.Hour).Unix())}, 0x2000, 0, now)
+	if len(store.List(now)) != 2 || len(store.List(now.Add(2*time.Hour))) != 1 {
+		t.Errorf("Expected the waypoint to expire after an hour")
+	}
+}
+
+// Test that sending a waypoint validates it, assigns an ID and records it
+func TestSendWaypoint(t *testing.T) {
+	sender := newFakeSender()
+	client, err := NewClient(sender, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	client.myNodeNum = 0x1000
+
+	if _, err := client.SendWaypoint(&Waypoint{Name: "Nowhere"}, 0); err == nil {
+		t.Errorf("Expected a waypoint without a position to be rejected")
+	}
+	if _, err := client.SendWaypoint(NewWaypoint("A name that is far too long to fit", "", 1, 2), 0); err == nil {
+		t.Errorf("Expected a long name to be rejected")
+	}
+
+	id, err := client.SendWaypoint(NewWaypoint("Trailhead", "", 47.6, -122.3), 2)
+	if err != nil {
+		t.Fatalf("SendWaypoint failed: %v", err)
+	}
+	<-sender.sent
+	record, ok := client.GetWaypoints().Get(id)
+	if id == 0 || !ok || record.From != 0x1000 || record.Channel != 2 {
+		t.Errorf("Expected the sent waypoint to be recorded, got %d %+v", id, record)
+	}
+
+	if err := client.DeleteWaypoint(id, 2); err != nil {
+		t.Fatalf("DeleteWaypoint failed: %v", err)
+	}
+	<-sender.sent
+	if _, ok := client.GetWaypoints().Get(id); ok {
+		t.Errorf("Expected the deleted waypoint to be removed")
+	}
+
+	// Waypoints locked to another node can't be changed from here
+	client.GetWaypoints().Update(&Waypoint{Id: 7, Name: "Theirs", LockedTo: 0x2000}, 0x2000, 0, client.Now())
+	if err := client.DeleteWaypoint(7, 0); err == nil {
+		t.Errorf("Expected deleting a waypoint locked to another node to fail")
+	}
+}
+
+// Test that waypoints decrypted locally are recorded with the channel index
+// rather than the channel hash the packet carries
+func TestUpdateWaypointsChannel(t *testing.T) {
+	client := newTestClient(t)
+	client.updateConfig(&Packet{DecodedData: &pb.Channel{
+		Index:    1,
+		Role:     pb.Channel_SECONDARY,
+		Settings: &pb.ChannelSettings{Name: "Hikers", Psk: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}},
+	}})
+	hikers, _ := client.GetChannels().ByIndex(1)
+	now := time.Unix(1700000000, 0)
+
+	packets := []*Packet{
+		{FromMesh: true, From: 0x2000, Channel: 1, RxTime: now, DecodedData: &Waypoint{Id: 1, Name: "Decoded"}},
+		{FromMesh: true, From: 0x2000, Channel: hikers.Hash, Encrypted: true, DecryptedWith: "Hikers", RxTime: now,
+			DecodedData: &Waypoint{Id: 2, Name: "Decrypted"}},
+		{FromMesh: true, From: 0x2000, Channel: 0x5C, Encrypted: true, DecryptedWith: "keyring", RxTime: now,
+			DecodedData: &Waypoint{Id: 3, Name: "Other key"}},
+	}
+	for _, packet := range packets {
+		client.updateWaypoints(packet)
+	}
+
+	for id, expected := range map[uint32]int32{1: 1, 2: 1, 3: -1} {
+		if record, ok := client.GetWaypoints().Get(id); !ok || record.Channel != expected {
+			t.Errorf("Expected waypoint %d on channel %d, got %+v", id, expected, record)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package pcap
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"go-mesh/internal/capture"
+	"go-mesh/internal/meshtastic"
+)
+
+// ConvertCapture writes the mesh packets in a capture file, and any rotated
+// parts, to w. Frames that aren't mesh packets, such as the device's config
+// dump, and log lines are skipped. It returns how many packets were written.
+func ConvertCapture(path string, w *Writer) (int, error) {
+	written := 0
+	for _, part := range capture.Parts(path) {
+		n, err := convertPart(part, w)
+		written += n
+		if err != nil {
+			return written, fmt.Errorf("%s: %w", part, err)
+		}
+	}
+	return written, nil
+}
+
+// convertPart converts the records of a single capture file
+func convertPart(path string, w *Writer) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	reader, err := capture.NewReader(f)
+	if err != nil {
+		return 0, err
+	}
+
+	written := 0
+	for {
+		rec, err := reader.Next()
+		if err == io.EOF {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+		if rec.Kind != capture.RecordFrame {
+			continue
+		}
+
+		packet, err := meshtastic.DecodeFrame(rec.Data, rec.Time)
+		if err != nil || !packet.FromMesh {
+			continue
+		}
+		if err := w.WritePacket(packet); err != nil {
+			return written, err
+		}
+		written++
+	}
+}
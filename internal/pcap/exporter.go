@@ -0,0 +1,110 @@
+package pcap
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+
+	"go-mesh/internal/meshtastic"
+)
+
+// Exporter writes mesh packets from a client to a pcapng file while started.
+// It is a PacketSubscriber and is safe for concurrent use.
+type Exporter struct {
+	source string
+	logger *log.Logger
+
+	mu     sync.Mutex
+	file   *os.File
+	writer *Writer
+	path   string
+}
+
+var _ meshtastic.PacketSubscriber = (*Exporter)(nil)
+
+// NewExporter creates a stopped exporter. Source is recorded as the interface
+// description of each file.
+func NewExporter(source string, logger *log.Logger) *Exporter {
+	return &Exporter{source: source, logger: logger}
+}
+
+// Start creates a pcapng file at path and exports packets to it, stopping
+// any export already running
+func (e *Exporter) Start(path string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err := e.closeFile(); err != nil {
+		e.logger.Printf("Failed to close pcapng file: %v", err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create pcapng file: %w", err)
+	}
+	writer, err := NewWriter(file, e.source)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	e.file = file
+	e.writer = writer
+	e.path = path
+	e.logger.Printf("Exporting packets to %s", path)
+	return nil
+}
+
+// Stop closes the current file, if any
+func (e *Exporter) Stop() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.closeFile()
+}
+
+// Status returns the file being written and how many packets it holds
+func (e *Exporter) Status() (path string, packets int, active bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.writer == nil {
+		return "", 0, false
+	}
+	return e.path, e.writer.Packets(), true
+}
+
+// OnPacket writes packets heard on the mesh; packets from the device itself
+// and ones we sent are skipped
+func (e *Exporter) OnPacket(packet *meshtastic.Packet) {
+	if !packet.FromMesh {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.writer == nil {
+		return
+	}
+	if err := e.writer.WritePacket(packet); err != nil {
+		e.logger.Printf("Failed to export packet %08x: %v", packet.ID, err)
+	}
+}
+
+// closeFile syncs and closes the current file
+func (e *Exporter) closeFile() error {
+	if e.file == nil {
+		return nil
+	}
+	file, path, packets := e.file, e.path, e.writer.Packets()
+	e.file, e.writer, e.path = nil, nil, ""
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to sync pcapng file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close pcapng file: %w", err)
+	}
+	e.logger.Printf("Exported %d packets to %s", packets, path)
+	return nil
+}
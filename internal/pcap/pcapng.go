@@ -0,0 +1,181 @@
+// Package pcap exports mesh packets as pcapng files that can be opened in
+// Wireshark.
+//
+// Each packet is written as an Enhanced Packet Block on an interface with link
+// type LINKTYPE_USER0 (147). The packet data starts with a fixed header in
+// network byte order, followed by the FromRadio frame the packet arrived in:
+//
+//	offset size field
+//	0      1    version (1)
+//	1      1    flags: 0x01 want_ack, 0x02 channel is a hash
+//	2      1    channel index, or the 8-bit channel hash sent on the air for
+//	            packets the device couldn't decode (flag 0x02 set)
+//	3      1    hop_limit
+//	4      1    hop_start (0 if the sender's firmware doesn't report it)
+//	5      1    priority
+//	6      2    header length, so later versions can add fields
+//	8      4    from node number
+//	12     4    to node number
+//	16     4    packet id
+//	20     4    rx_snr as an IEEE 754 float32
+//	24     4    rx_rssi as a signed int32
+//	28     ...  FromRadio protobuf (proto/meshtastic/mesh.proto)
+//
+// In Wireshark, add an entry for User 0 (DLT=147) under Preferences, Protocols,
+// DLT_USER with a header size of 28 and payload protocol "protobuf" to decode
+// the frame, or use the header fields directly in a Lua dissector.
+package pcap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"go-mesh/internal/meshtastic"
+)
+
+// LinkTypeMeshtastic is the link type of the exported interface, LINKTYPE_USER0
+const LinkTypeMeshtastic = 147
+
+// HeaderVersion is the version of the packet header written by this package
+const HeaderVersion = 1
+
+// HeaderLen is the size of the header before the FromRadio frame
+const HeaderLen = 28
+
+// Header flags
+const (
+	FlagWantAck     = 0x01
+	FlagChannelHash = 0x02 // The channel byte is the on-air hash, not an index
+)
+
+// pcapng block types and option codes
+const (
+	blockSectionHeader  = 0x0A0D0D0A
+	blockInterfaceDesc  = 0x00000001
+	blockEnhancedPacket = 0x00000006
+	byteOrderMagic      = 0x1A2B3C4D
+	optEndOfOpt         = 0
+	optSHBUserAppl      = 4
+	optIfName           = 2
+	optIfDescription    = 3
+	optIfTsresol        = 9
+	tsresolNanoseconds  = 9
+)
+
+// Writer writes a pcapng section with a single Meshtastic interface. It is not
+// safe for concurrent use.
+type Writer struct {
+	w       io.Writer
+	packets int
+}
+
+// NewWriter writes the section header and interface description to w.
+// Source describes where the packets came from, e.g. the connection info.
+func NewWriter(w io.Writer, source string) (*Writer, error) {
+	shb := make([]byte, 0, 64)
+	shb = binary.LittleEndian.AppendUint32(shb, byteOrderMagic)
+	shb = binary.LittleEndian.AppendUint16(shb, 1)              // Major version
+	shb = binary.LittleEndian.AppendUint16(shb, 0)              // Minor version
+	shb = binary.LittleEndian.AppendUint64(shb, math.MaxUint64) // Section length not specified
+	shb = appendOption(shb, optSHBUserAppl, []byte("go-mesh mesh-debug"))
+	shb = appendOption(shb, optEndOfOpt, nil)
+	if err := writeBlock(w, blockSectionHeader, shb); err != nil {
+		return nil, fmt.Errorf("failed to write pcapng section header: %w", err)
+	}
+
+	idb := make([]byte, 0, 64)
+	idb = binary.LittleEndian.AppendUint16(idb, LinkTypeMeshtastic)
+	idb = binary.LittleEndian.AppendUint16(idb, 0) // Reserved
+	idb = binary.LittleEndian.AppendUint32(idb, 0) // No snap length limit
+	idb = appendOption(idb, optIfName, []byte("meshtastic"))
+	if source != "" {
+		idb = appendOption(idb, optIfDescription, []byte(source))
+	}
+	idb = appendOption(idb, optIfTsresol, []byte{tsresolNanoseconds})
+	idb = appendOption(idb, optEndOfOpt, nil)
+	if err := writeBlock(w, blockInterfaceDesc, idb); err != nil {
+		return nil, fmt.Errorf("failed to write pcapng interface description: %w", err)
+	}
+
+	return &Writer{w: w}, nil
+}
+
+// WritePacket writes a mesh packet with its receive time as the timestamp
+func (w *Writer) WritePacket(packet *meshtastic.Packet) error {
+	data := EncodePacket(packet)
+	ts := uint64(packet.RxTime.UnixNano())
+
+	epb := make([]byte, 0, 20+len(data)+3)
+	epb = binary.LittleEndian.AppendUint32(epb, 0) // Interface ID
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(ts>>32))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(ts))
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(len(data))) // Captured length
+	epb = binary.LittleEndian.AppendUint32(epb, uint32(len(data))) // Original length
+	epb = append(epb, data...)
+	epb = appendPadding(epb)
+
+	if err := writeBlock(w.w, blockEnhancedPacket, epb); err != nil {
+		return fmt.Errorf("failed to write pcapng packet: %w", err)
+	}
+	w.packets++
+	return nil
+}
+
+// Packets returns how many packets have been written
+func (w *Writer) Packets() int {
+	return w.packets
+}
+
+// EncodePacket builds the link-layer header described in the package
+// documentation followed by the packet's raw FromRadio frame
+func EncodePacket(packet *meshtastic.Packet) []byte {
+	var flags byte
+	if packet.WantAck {
+		flags |= FlagWantAck
+	}
+	if packet.Encrypted {
+		// Still encrypted when the device handed it over, even if we
+		// decrypted it since, so MeshPacket.channel holds the hash
+		flags |= FlagChannelHash
+	}
+
+	buf := make([]byte, 0, HeaderLen+len(packet.Raw))
+	buf = append(buf, HeaderVersion, flags, packet.Channel, packet.HopLimit, packet.HopStart, packet.Priority)
+	buf = binary.BigEndian.AppendUint16(buf, HeaderLen)
+	buf = binary.BigEndian.AppendUint32(buf, packet.From)
+	buf = binary.BigEndian.AppendUint32(buf, packet.To)
+	buf = binary.BigEndian.AppendUint32(buf, packet.ID)
+	buf = binary.BigEndian.AppendUint32(buf, math.Float32bits(packet.RxSNR))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(packet.RxRSSI))
+	return append(buf, packet.Raw...)
+}
+
+// writeBlock writes a pcapng block with its leading and trailing total length
+func writeBlock(w io.Writer, blockType uint32, body []byte) error {
+	total := uint32(12 + len(body))
+	block := make([]byte, 0, total)
+	block = binary.LittleEndian.AppendUint32(block, blockType)
+	block = binary.LittleEndian.AppendUint32(block, total)
+	block = append(block, body...)
+	block = binary.LittleEndian.AppendUint32(block, total)
+	_, err := w.Write(block)
+	return err
+}
+
+// appendOption appends a pcapng option padded to 32 bits
+func appendOption(buf []byte, code uint16, value []byte) []byte {
+	buf = binary.LittleEndian.AppendUint16(buf, code)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(value)))
+	buf = append(buf, value...)
+	return appendPadding(buf)
+}
+
+// appendPadding pads buf with zeros to a multiple of 4 bytes
+func appendPadding(buf []byte) []byte {
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
@@ -0,0 +1,183 @@
+package pcap
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go-mesh/internal/capture"
+	"go-mesh/internal/meshtastic"
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// block is a pcapng block read back from a file
+type block struct {
+	Type uint32
+	Body []byte
+}
+
+// readBlocks splits pcapng data into blocks, checking the trailing lengths
+func readBlocks(t *testing.T, data []byte) []block {
+	t.Helper()
+	var blocks []block
+	for len(data) > 0 {
+		if len(data) < 12 {
+			t.Fatalf("Truncated block: %d bytes left", len(data))
+		}
+		total := binary.LittleEndian.Uint32(data[4:8])
+		if total%4 != 0 || int(total) > len(data) {
+			t.Fatalf("Bad block length %d", total)
+		}
+		if trailer := binary.LittleEndian.Uint32(data[total-4 : total]); trailer != total {
+			t.Fatalf("Trailing length %d doesn't match %d", trailer, total)
+		}
+		blocks = append(blocks, block{Type: binary.LittleEndian.Uint32(data[:4]), Body: data[8 : total-4]})
+		data = data[total:]
+	}
+	return blocks
+}
+
+// packetData returns the timestamp and data of an Enhanced Packet Block
+func packetData(t *testing.T, b block) (time.Time, []byte) {
+	t.Helper()
+	if b.Type != blockEnhancedPacket {
+		t.Fatalf("Expected an enhanced packet block, got type %#x", b.Type)
+	}
+	ts := uint64(binary.LittleEndian.Uint32(b.Body[4:8]))<<32 | uint64(binary.LittleEndian.Uint32(b.Body[8:12]))
+	length := binary.LittleEndian.Uint32(b.Body[12:16])
+	return time.Unix(0, int64(ts)), b.Body[20 : 20+length]
+}
+
+func meshFrame(t *testing.T, meshPacket *pb.MeshPacket) []byte {
+	t.Helper()
+	data, err := proto.Marshal(&pb.FromRadio{PayloadVariant: &pb.FromRadio_Packet{Packet: meshPacket}})
+	if err != nil {
+		t.Fatalf("failed to marshal FromRadio: %v", err)
+	}
+	return data
+}
+
+// Test the section and interface headers and the packet header layout
+func TestWriterPacket(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "TCP: 192.168.1.10:4403")
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+
+	rxTime := time.Date(2025, 3, 1, 12, 0, 0, 123456789, time.UTC)
+	packet := &meshtastic.Packet{
+		ID:       0xDEADBEEF,
+		From:     0x11223344,
+		To:       0xFFFFFFFF,
+		Channel:  8,
+		HopLimit: 1,
+		HopStart: 3,
+		WantAck:  true,
+		Priority: 64,
+		RxTime:   rxTime,
+		RxSNR:    -6.25,
+		RxRSSI:   -110,
+		Raw:      []byte{0x11, 0x22, 0x33},
+	}
+	if err := w.WritePacket(packet); err != nil {
+		t.Fatalf("WritePacket failed: %v", err)
+	}
+
+	blocks := readBlocks(t, buf.Bytes())
+	if len(blocks) != 3 || blocks[0].Type != blockSectionHeader || blocks[1].Type != blockInterfaceDesc {
+		t.Fatalf("Expected section header, interface and one packet, got %+v", blocks)
+	}
+	if magic := binary.LittleEndian.Uint32(blocks[0].Body[:4]); magic != byteOrderMagic {
+		t.Errorf("Unexpected byte order magic %#x", magic)
+	}
+	if linkType := binary.LittleEndian.Uint16(blocks[1].Body[:2]); linkType != LinkTypeMeshtastic {
+		t.Errorf("Expected link type %d, got %d", LinkTypeMeshtastic, linkType)
+	}
+
+	ts, data := packetData(t, blocks[2])
+	if !ts.Equal(rxTime) {
+		t.Errorf("Expected timestamp %v, got %v", rxTime, ts)
+	}
+	if len(data) != HeaderLen+3 || !bytes.Equal(data[HeaderLen:], packet.Raw) {
+		t.Fatalf("Expected header and raw frame, got %x", data)
+	}
+	if data[0] != HeaderVersion || data[1] != FlagWantAck || data[2] != 8 || data[3] != 1 || data[4] != 3 || data[5] != 64 {
+		t.Errorf("Unexpected header bytes %x", data[:6])
+	}
+	if binary.BigEndian.Uint16(data[6:8]) != HeaderLen {
+		t.Errorf("Unexpected header length %d", binary.BigEndian.Uint16(data[6:8]))
+	}
+	if binary.BigEndian.Uint32(data[8:12]) != 0x11223344 || binary.BigEndian.Uint32(data[12:16]) != 0xFFFFFFFF ||
+		binary.BigEndian.Uint32(data[16:20]) != 0xDEADBEEF {
+		t.Errorf("Unexpected addressing %x", data[8:20])
+	}
+	if snr := math.Float32frombits(binary.BigEndian.Uint32(data[20:24])); snr != -6.25 {
+		t.Errorf("Expected SNR -6.25, got %v", snr)
+	}
+	if rssi := int32(binary.BigEndian.Uint32(data[24:28])); rssi != -110 {
+		t.Errorf("Expected RSSI -110, got %d", rssi)
+	}
+
+	// Packets the device couldn't decode carry the channel hash
+	packet.Encrypted, packet.Channel = true, 0x5C
+	if header := EncodePacket(packet); header[1] != FlagWantAck|FlagChannelHash || header[2] != 0x5C {
+		t.Errorf("Expected the channel hash flag, got %x", header[:3])
+	}
+}
+
+// Test that converting a capture keeps only mesh packets, across rotated parts
+func TestConvertCapture(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.mcap")
+	rec, err := capture.NewWriter(path, capture.FileHeader{Transport: "serial"}, capture.RotateOptions{MaxSize: 100})
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	myInfo, _ := proto.Marshal(&pb.FromRadio{PayloadVariant: &pb.FromRadio_MyInfo{MyInfo: &pb.MyNodeInfo{MyNodeNum: 0x1234}}})
+	rec.RecordFrame(myInfo, start)
+	rec.RecordLog("INFO | Booting", start)
+	for i := 0; i < 5; i++ {
+		rec.RecordFrame(meshFrame(t, &pb.MeshPacket{
+			From:    0x11223344,
+			To:      0xFFFFFFFF,
+			Id:      uint32(i + 1),
+			RxRssi:  -90,
+			Channel: 8,
+			PayloadVariant: &pb.MeshPacket_Decoded{Decoded: &pb.Data{
+				Portnum: pb.PortNum_TEXT_MESSAGE_APP,
+				Payload: bytes.Repeat([]byte("x"), 40),
+			}},
+		}), start.Add(time.Duration(i)*time.Second))
+	}
+	rec.Close()
+	if parts := capture.Parts(path); len(parts) < 2 {
+		t.Fatalf("Expected the capture to rotate, got %v", parts)
+	}
+
+	var buf bytes.Buffer
+	w, _ := NewWriter(&buf, "")
+	written, err := ConvertCapture(path, w)
+	if err != nil {
+		t.Fatalf("ConvertCapture failed: %v", err)
+	}
+	if written != 5 {
+		t.Fatalf("Expected 5 packets, got %d", written)
+	}
+
+	blocks := readBlocks(t, buf.Bytes())[2:]
+	for i, b := range blocks {
+		ts, data := packetData(t, b)
+		if !ts.Equal(start.Add(time.Duration(i) * time.Second)) {
+			t.Errorf("Packet %d has timestamp %v", i, ts)
+		}
+		if id := binary.BigEndian.Uint32(data[16:20]); id != uint32(i+1) {
+			t.Errorf("Packet %d has ID %d", i, id)
+		}
+	}
+}
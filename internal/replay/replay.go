@@ -0,0 +1,355 @@
+package replay
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"go-mesh/internal/capture"
+	"go-mesh/internal/meshtastic"
+)
+
+// Connection plays back a capture file, and any rotated parts, as if the
+// frames were arriving from a device
+type Connection struct {
+	path   string
+	parts  []string
+	speed  float64 // 1 is original speed, 0 is as fast as possible
+	logger *log.Logger
+
+	mu         sync.RWMutex
+	header     capture.FileHeader
+	connected  bool
+	closed     bool
+	paused     bool
+	finished   bool
+	now        time.Time // Receive time of the last delivered record
+	delivered  int
+	total      int
+	logHandler func(string)
+
+	// Wake the playback loop when paused, resumed, stepped or closed
+	control chan struct{}
+	step    chan struct{}
+	done    chan struct{}
+}
+
+// Connection replays logs and exposes playback controls to the TUI
+var _ meshtastic.DebugLogSource = (*Connection)(nil)
+var _ meshtastic.ReplayControl = (*Connection)(nil)
+var _ meshtastic.Clock = (*Connection)(nil)
+
+// NewConnection creates a replay of the capture at path. Speed is a multiple
+// of the original timing, 0 to replay as fast as possible.
+func NewConnection(path string, speed float64, startPaused bool, logger *log.Logger) (*Connection, error) {
+	if path == "" {
+		return nil, fmt.Errorf("capture file cannot be empty")
+	}
+	if speed < 0 {
+		return nil, fmt.Errorf("replay speed cannot be negative")
+	}
+
+	conn := &Connection{
+		path:    path,
+		speed:   speed,
+		paused:  startPaused,
+		logger:  logger,
+		control: make(chan struct{}, 1),
+		step:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	conn.logger.Printf("Created replay connection for %s (speed %g)", path, speed)
+	return conn, nil
+}
+
+// Connect checks the capture and counts its records
+func (c *Connection) Connect() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return fmt.Errorf("connection is closed")
+	}
+
+	c.parts = capture.Parts(c.path)
+	c.total = 0
+	for i, part := range c.parts {
+		header, first, count, err := scanPart(part)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			c.header = header
+			c.now = header.Created
+			if first != nil {
+				c.now = first.Time
+			}
+		}
+		c.total += count
+	}
+
+	c.connected = true
+	c.logger.Printf("Replaying %d records from %d file(s), recorded from %s", c.total, len(c.parts), c.header.Connection)
+	return nil
+}
+
+// StartPacketListener delivers the recorded frames to handler, waiting
+// between them according to the replay speed
+func (c *Connection) StartPacketListener(handler func([]byte) error) error {
+	c.mu.RLock()
+	if c.closed || !c.connected {
+		c.mu.RUnlock()
+		return fmt.Errorf("connection not established")
+	}
+	logHandler := c.logHandler
+	c.mu.RUnlock()
+
+	var last time.Time
+	for _, part := range c.parts {
+		err := c.replayPart(part, func(rec *capture.Record) error {
+			delay := time.Duration(0)
+			if !last.IsZero() && c.speed > 0 {
+				delay = time.Duration(float64(rec.Time.Sub(last)) / c.speed)
+			}
+			last = rec.Time
+
+			if !c.waitTurn(delay) {
+				return errClosed
+			}
+
+			c.mu.Lock()
+			c.now = rec.Time
+			c.delivered++
+			c.mu.Unlock()
+
+			switch rec.Kind {
+			case capture.RecordFrame:
+				if err := handler(rec.Data); err != nil {
+					c.logger.Printf("Error handling replayed frame: %v", err)
+				}
+			case capture.RecordLog:
+				if logHandler != nil {
+					logHandler(string(rec.Data))
+				}
+			}
+			return nil
+		})
+		if err == errClosed {
+			return nil
+		}
+		if err != nil {
+			c.logger.Printf("Replay of %s stopped: %v", part, err)
+			break
+		}
+	}
+
+	c.mu.Lock()
+	c.finished = true
+	c.mu.Unlock()
+	c.logger.Printf("Replay finished")
+	return nil
+}
+
+// errClosed stops playback when the connection is closed
+var errClosed = fmt.Errorf("replay closed")
+
+// replayPart calls deliver for each record in one capture file
+func (c *Connection) replayPart(path string, deliver func(*capture.Record) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	reader, err := capture.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	for {
+		rec, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := deliver(rec); err != nil {
+			return err
+		}
+	}
+}
+
+// waitTurn blocks until the next record is due: its delay has passed while
+// playing, or the user stepped. It returns false if the connection closed.
+func (c *Connection) waitTurn(delay time.Duration) bool {
+	for {
+		c.mu.RLock()
+		paused := c.paused
+		c.mu.RUnlock()
+
+		if paused {
+			select {
+			case <-c.step:
+				return true
+			case <-c.control:
+				continue
+			case <-c.done:
+				return false
+			}
+		}
+
+		if delay <= 0 {
+			select {
+			case <-c.done:
+				return false
+			default:
+				return true
+			}
+		}
+
+		start := time.Now()
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+			return true
+		case <-c.step:
+			// Stepping while playing skips the wait
+			timer.Stop()
+			return true
+		case <-c.control:
+			timer.Stop()
+			delay -= time.Since(start)
+		case <-c.done:
+			timer.Stop()
+			return false
+		}
+	}
+}
+
+// Pause stops delivering records until Resume or Step
+func (c *Connection) Pause() {
+	c.setPaused(true)
+}
+
+// Resume continues playback at the configured speed
+func (c *Connection) Resume() {
+	c.setPaused(false)
+}
+
+// Step delivers the next record
+func (c *Connection) Step() {
+	select {
+	case c.step <- struct{}{}:
+	default:
+	}
+}
+
+// Progress returns how far playback has got
+func (c *Connection) Progress() meshtastic.ReplayProgress {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return meshtastic.ReplayProgress{
+		Delivered: c.delivered,
+		Total:     c.total,
+		Time:      c.now,
+		Speed:     c.speed,
+		Paused:    c.paused,
+		Finished:  c.finished,
+	}
+}
+
+// Now returns the receive time of the record being delivered, so packets
+// carry their original timestamps
+func (c *Connection) Now() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.now
+}
+
+// setPaused updates the pause state and wakes the playback loop
+func (c *Connection) setPaused(paused bool) {
+	c.mu.Lock()
+	c.paused = paused
+	c.mu.Unlock()
+
+	select {
+	case c.control <- struct{}{}:
+	default:
+	}
+}
+
+// SetDebugLogHandler sets where recorded log lines are sent.
+// It must be called before StartPacketListener.
+func (c *Connection) SetDebugLogHandler(handler func(line string)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.logHandler = handler
+}
+
+// SendCommand fails, a recording can't be sent to
+func (c *Connection) SendCommand(command string) error {
+	return fmt.Errorf("cannot send commands while replaying a capture")
+}
+
+// Close stops playback
+func (c *Connection) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	c.connected = false
+	close(c.done)
+	return nil
+}
+
+// IsConnected returns true until the replay is closed
+func (c *Connection) IsConnected() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.connected && !c.closed
+}
+
+// GetConnectionInfo returns connection information string
+func (c *Connection) GetConnectionInfo() string {
+	if !c.IsConnected() {
+		return "Disconnected"
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return fmt.Sprintf("Replaying %s (%s capture of %s)", c.path, c.header.Transport, c.header.Connection)
+}
+
+// scanPart reads a capture file's header, first record and record count
+func scanPart(path string) (capture.FileHeader, *capture.Record, int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return capture.FileHeader{}, nil, 0, fmt.Errorf("failed to open capture: %w", err)
+	}
+	defer f.Close()
+
+	reader, err := capture.NewReader(f)
+	if err != nil {
+		return capture.FileHeader{}, nil, 0, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var first *capture.Record
+	count := 0
+	for {
+		rec, err := reader.Next()
+		if err != nil {
+			// A truncated final record is skipped, as it would be during playback
+			return reader.Header, first, count, nil
+		}
+		if first == nil {
+			first = rec
+		}
+		count++
+	}
+}
@@ -0,0 +1,116 @@
+package replay
+
+import (
+	"io"
+	"log"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go-mesh/internal/capture"
+)
+
+// writeCapture records three frames a second apart and a log line, rotating after each frame
+func writeCapture(t *testing.T) (string, time.Time) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "session.mcap")
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	w, err := capture.NewWriter(path, capture.FileHeader{Transport: "tcp", Connection: "test"}, capture.RotateOptions{MaxSize: 1})
+	if err != nil {
+		t.Fatalf("NewWriter failed: %v", err)
+	}
+	w.RecordFrame([]byte{1}, start)
+	w.RecordLog("INFO | hello", start.Add(500*time.Millisecond))
+	w.RecordFrame([]byte{2}, start.Add(time.Second))
+	w.RecordFrame([]byte{3}, start.Add(2*time.Second))
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	return path, start
+}
+
+func newTestConnection(t *testing.T, path string, speed float64, paused bool) *Connection {
+	t.Helper()
+	conn, err := NewConnection(path, speed, paused, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewConnection failed: %v", err)
+	}
+	if err := conn.Connect(); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	return conn
+}
+
+// Test that every part is replayed in order with original timestamps as the clock
+func TestReplayAllParts(t *testing.T) {
+	path, start := writeCapture(t)
+	conn := newTestConnection(t, path, 0, false)
+	defer conn.Close()
+
+	if progress := conn.Progress(); progress.Total != 4 || !conn.Now().Equal(start) {
+		t.Fatalf("Unexpected state after connect: %+v", progress)
+	}
+
+	var frames []byte
+	var frameTimes []time.Time
+	var logs []string
+	conn.SetDebugLogHandler(func(line string) { logs = append(logs, line) })
+	conn.StartPacketListener(func(data []byte) error {
+		frames = append(frames, data[0])
+		frameTimes = append(frameTimes, conn.Now())
+		return nil
+	})
+
+	if string(frames) != "\x01\x02\x03" || len(logs) != 1 || logs[0] != "INFO | hello" {
+		t.Errorf("Unexpected replay: frames %v, logs %v", frames, logs)
+	}
+	if !frameTimes[2].Equal(start.Add(2 * time.Second)) {
+		t.Errorf("Expected clock at last frame time, got %v", frameTimes[2])
+	}
+	if progress := conn.Progress(); !progress.Finished || progress.Delivered != 4 {
+		t.Errorf("Unexpected progress: %+v", progress)
+	}
+}
+
+// Test that a paused replay delivers one record per step
+func TestReplayPauseAndStep(t *testing.T) {
+	path, _ := writeCapture(t)
+	conn := newTestConnection(t, path, 1, true)
+
+	frames := make(chan byte, 4)
+	done := make(chan struct{})
+	go func() {
+		conn.StartPacketListener(func(data []byte) error {
+			frames <- data[0]
+			return nil
+		})
+		close(done)
+	}()
+
+	select {
+	case <-frames:
+		t.Fatal("Expected no frames while paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	conn.Step()
+	select {
+	case frame := <-frames:
+		if frame != 1 {
+			t.Errorf("Expected first frame, got %d", frame)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Step did not deliver a frame")
+	}
+	if delivered := conn.Progress().Delivered; delivered != 1 {
+		t.Errorf("Expected 1 record delivered, got %d", delivered)
+	}
+
+	conn.Close()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Replay did not stop on Close")
+	}
+}
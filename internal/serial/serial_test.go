@@ -0,0 +1,162 @@
+package serial
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"go-mesh/internal/meshtastic"
+	"go.bug.st/serial"
+)
+
+// fakePort records what is written to it
+type fakePort struct {
+	serial.Port
+	mu         sync.Mutex
+	written    bytes.Buffer
+	closed     bool
+	timeoutErr error
+}
+
+func (p *fakePort) Write(data []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return 0, errors.New("port closed")
+	}
+	return p.written.Write(data)
+}
+
+func (p *fakePort) SetReadTimeout(time.Duration) error {
+	return p.timeoutErr
+}
+
+func (p *fakePort) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	return nil
+}
+
+func (p *fakePort) isClosed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.closed
+}
+
+// withPorts makes Connect open the given ports in turn
+func withPorts(t *testing.T, ports ...*fakePort) {
+	t.Helper()
+	var mu sync.Mutex
+	openPort = func(string, *serial.Mode) (serial.Port, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(ports) == 0 {
+			return nil, errors.New("no such port")
+		}
+		port := ports[0]
+		ports = ports[1:]
+		return port, nil
+	}
+	t.Cleanup(func() { openPort = serial.Open })
+}
+
+func newTestConnection(t *testing.T) *Connection {
+	t.Helper()
+	conn, err := NewConnection("/dev/ttyTEST", 115200, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewConnection failed: %v", err)
+	}
+	return conn
+}
+
+// Test that a reopen failing part way leaves the old port in place and the
+// connection marked down
+func TestReopenFailure(t *testing.T) {
+	first := &fakePort{}
+	broken := &fakePort{timeoutErr: errors.New("device gone")}
+	withPorts(t, first, broken)
+	conn := newTestConnection(t)
+
+	if err := conn.Connect(); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	if !conn.IsConnected() || !bytes.Equal(first.written.Bytes(), meshtastic.WakeUpSequence()) {
+		t.Fatalf("Expected the port open and woken up, got %x", first.written.Bytes())
+	}
+
+	// The listener marks the link down before reopening
+	conn.mu.Lock()
+	conn.open = false
+	conn.mu.Unlock()
+
+	for _, attempt := range []string{"read timeout", "open"} {
+		if err := conn.Connect(); err == nil {
+			t.Fatalf("Expected the %s failure to fail Connect", attempt)
+		}
+		if conn.IsConnected() {
+			t.Errorf("Expected the connection to stay down after the %s failure", attempt)
+		}
+		if conn.port != first || conn.writer != first {
+			t.Errorf("Expected the %s failure to leave the old port in place", attempt)
+		}
+	}
+	if !broken.isClosed() || broken.written.Len() != 0 {
+		t.Errorf("Expected the half opened port to be closed without writing to it")
+	}
+}
+
+// Test that frames written while the port is reopened go to the old or the
+// new port, never to one still being set up
+func TestWriteDuringReconnect(t *testing.T) {
+	ports := []*fakePort{{}, {}, {}}
+	withPorts(t, ports...)
+	conn := newTestConnection(t)
+	if err := conn.Connect(); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+
+	// Writes racing the close of the old port may fail, as on a real device
+	stop, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				conn.SendHeartbeat()
+			}
+		}
+	}()
+
+	for range ports[1:] {
+		if err := conn.Connect(); err != nil {
+			t.Fatalf("Reconnect failed: %v", err)
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(stop)
+	<-stopped
+
+	wakeUp := meshtastic.WakeUpSequence()
+	for i, port := range ports {
+		written := port.written.Bytes()
+		if !bytes.HasPrefix(written, wakeUp) {
+			t.Errorf("Expected port %d to be woken up before any frame", i)
+		}
+		if len(written) > len(wakeUp) && written[len(wakeUp)] != meshtastic.START1 {
+			t.Errorf("Expected whole frames after the wake-up on port %d", i)
+		}
+		if i < len(ports)-1 && !port.isClosed() {
+			t.Errorf("Expected port %d to be closed by the reconnect", i)
+		}
+	}
+	if last := ports[len(ports)-1]; last.written.Len() <= len(wakeUp) {
+		t.Errorf("Expected heartbeats on the new port")
+	}
+}
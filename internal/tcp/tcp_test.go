@@ -0,0 +1,175 @@
+package tcp
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"go-mesh/internal/meshtastic"
+	"go-mesh/pb/meshtastic"
+	"google.golang.org/protobuf/proto"
+)
+
+// listen accepts connections on a local port and passes each one on
+func listen(t *testing.T) (net.Listener, <-chan net.Conn) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	accepted := make(chan net.Conn, 4)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			accepted <- conn
+		}
+	}()
+	return listener, accepted
+}
+
+func newTestConnection(t *testing.T, listener net.Listener) *Connection {
+	t.Helper()
+	addr := listener.Addr().(*net.TCPAddr)
+	conn, err := NewConnection(addr.IP.String(), addr.Port, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewConnection failed: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// readWakeUp reads the wake-up sequence the device side should see first
+func readWakeUp(t *testing.T, conn net.Conn) {
+	t.Helper()
+	wakeUp := make([]byte, meshtastic.WAKE_UP_LEN)
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := io.ReadFull(conn, wakeUp); err != nil || !bytes.Equal(wakeUp, meshtastic.WakeUpSequence()) {
+		t.Fatalf("Expected the wake-up sequence, got %x (%v)", wakeUp, err)
+	}
+}
+
+// Test that a failed reconnect leaves the connection down and the old
+// socket in place
+func TestReconnectFailure(t *testing.T) {
+	listener, accepted := listen(t)
+	conn := newTestConnection(t, listener)
+	if err := conn.Connect(); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	readWakeUp(t, <-accepted)
+	old := conn.conn
+
+	conn.mu.Lock()
+	conn.connected = false
+	conn.mu.Unlock()
+	listener.Close()
+
+	if err := conn.Connect(); err == nil {
+		t.Fatal("Expected Connect to fail with nothing listening")
+	}
+	if conn.IsConnected() || conn.conn != old {
+		t.Errorf("Expected the failed reconnect to leave the connection down and unchanged")
+	}
+}
+
+// Test that frames written while reconnecting go to the old or the new
+// socket, and the device sees the wake-up sequence before any frame
+func TestWriteDuringReconnect(t *testing.T) {
+	listener, accepted := listen(t)
+	conn := newTestConnection(t, listener)
+	if err := conn.Connect(); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	readWakeUp(t, <-accepted)
+
+	// Writes racing the close of the old socket may fail, as on a real link
+	stop, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				conn.SendHeartbeat()
+				time.Sleep(time.Millisecond)
+			}
+		}
+	}()
+
+	if err := conn.Connect(); err != nil {
+		t.Fatalf("Reconnect failed: %v", err)
+	}
+	device := <-accepted
+	readWakeUp(t, device)
+
+	frame := make([]byte, 1)
+	if _, err := io.ReadFull(device, frame); err != nil || frame[0] != meshtastic.START1 {
+		t.Errorf("Expected a frame after the wake-up sequence, got %x (%v)", frame, err)
+	}
+	close(stop)
+	<-stopped
+}
+
+// Test that an idle session sends heartbeats at the interval and a peer that
+// stays silent for StallTimeout is treated as a dropped link
+func TestHeartbeatAndStall(t *testing.T) {
+	const interval, stall = 40 * time.Millisecond, 300 * time.Millisecond
+
+	conn, err := NewConnection("device.invalid", 4403, log.New(io.Discard, "", 0))
+	if err != nil {
+		t.Fatalf("NewConnection failed: %v", err)
+	}
+	client, device := net.Pipe()
+	conn.conn, conn.connected = client, true
+	conn.HeartbeatInterval, conn.StallTimeout = interval, stall
+
+	events := make(chan meshtastic.ConnectionEvent, 4)
+	conn.SetConnectionEventHandler(func(event meshtastic.ConnectionEvent) { events <- event })
+
+	// The device reads ToRadio frames but never answers
+	heartbeats := make(chan time.Time, 64)
+	framer := meshtastic.NewStreamFramer(func(payload []byte) error {
+		toRadio := &pb.ToRadio{}
+		if err := proto.Unmarshal(payload, toRadio); err == nil && toRadio.GetHeartbeat() != nil {
+			heartbeats <- time.Now()
+		}
+		return nil
+	}, nil, log.New(io.Discard, "", 0))
+	go io.Copy(framer, device)
+
+	start := time.Now()
+	go conn.StartPacketListener(func([]byte) error { return nil })
+	defer conn.Close()
+
+	var event meshtastic.ConnectionEvent
+	select {
+	case event = <-events:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the silent link to be dropped")
+	}
+	if elapsed := time.Since(start); event.State != meshtastic.LinkLost || elapsed < stall {
+		t.Errorf("Expected the link lost after %v, got %s after %v", stall, event.State, elapsed)
+	}
+
+	var times []time.Time
+	for len(heartbeats) > 0 {
+		times = append(times, <-heartbeats)
+	}
+	if len(times) < 3 || len(times) > int(stall/interval)+2 {
+		t.Errorf("Expected a heartbeat every %v for %v, got %d", interval, stall, len(times))
+	}
+	for i := 1; i < len(times); i++ {
+		if gap := times[i].Sub(times[i-1]); gap < interval*3/4 {
+			t.Errorf("Expected heartbeats %v apart, got %v", interval, gap)
+		}
+	}
+}
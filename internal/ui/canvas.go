@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// brailleDots maps a dot's column and row within a character cell to its bit
+// in the braille pattern block, which starts at U+2800
+var brailleDots = [4][2]rune{
+	{0x01, 0x08},
+	{0x02, 0x10},
+	{0x04, 0x20},
+	{0x40, 0x80},
+}
+
+// canvasMark is how a character drawn over the canvas is styled
+type canvasMark int
+
+const (
+	markNone canvasMark = iota
+	markNode
+	markSelf
+	markWaypoint
+	markRing
+)
+
+// brailleCanvas plots dots on a grid of braille characters, each cell
+// holding 2x4 dots, with text drawn over the dots
+type brailleCanvas struct {
+	cols, rows int
+	dots       [][]rune
+	text       [][]rune
+	marks      [][]canvasMark
+}
+
+func newBrailleCanvas(cols, rows int) *brailleCanvas {
+	c := &brailleCanvas{cols: cols, rows: rows}
+	c.dots = make([][]rune, rows)
+	c.text = make([][]rune, rows)
+	c.marks = make([][]canvasMark, rows)
+	for row := range c.dots {
+		c.dots[row] = make([]rune, cols)
+		c.text[row] = make([]rune, cols)
+		c.marks[row] = make([]canvasMark, cols)
+	}
+	return c
+}
+
+// width and height return the canvas size in dots
+func (c *brailleCanvas) width() int  { return c.cols * 2 }
+func (c *brailleCanvas) height() int { return c.rows * 4 }
+
+// set plots the dot at x, y, ignoring dots off the canvas
+func (c *brailleCanvas) set(x, y int) {
+	if x < 0 || y < 0 || x >= c.width() || y >= c.height() {
+		return
+	}
+	c.dots[y/4][x/2] |= brailleDots[y%4][x%2]
+}
+
+// line draws a line between two points given in dots, clipped to the canvas
+func (c *brailleCanvas) line(x0, y0, x1, y1 float64) {
+	x0, y0, x1, y1, ok := clipLine(x0, y0, x1, y1, float64(c.width()-1), float64(c.height()-1))
+	if !ok {
+		return
+	}
+	steps := int(math.Max(math.Abs(x1-x0), math.Abs(y1-y0))) + 1
+	for i := 0; i <= steps; i++ {
+		t := float64(i) / float64(steps)
+		c.set(int(math.Round(x0+(x1-x0)*t)), int(math.Round(y0+(y1-y0)*t)))
+	}
+}
+
+// clipLine clips a line to the box from 0,0 to maxX,maxY with the
+// Liang-Barsky algorithm, reporting false if it misses the box
+func clipLine(x0, y0, x1, y1, maxX, maxY float64) (float64, float64, float64, float64, bool) {
+	dx, dy := x1-x0, y1-y0
+	t0, t1 := 0.0, 1.0
+	for _, edge := range [4][2]float64{{-dx, x0}, {dx, maxX - x0}, {-dy, y0}, {dy, maxY - y0}} {
+		p, q := edge[0], edge[1]
+		if p == 0 {
+			if q < 0 {
+				return 0, 0, 0, 0, false
+			}
+			continue
+		}
+		t := q / p
+		if p < 0 {
+			t0 = math.Max(t0, t)
+		} else {
+			t1 = math.Min(t1, t)
+		}
+	}
+	if t0 > t1 {
+		return 0, 0, 0, 0, false
+	}
+	return x0 + t0*dx, y0 + t0*dy, x0 + t1*dx, y0 + t1*dy, true
+}
+
+// cell returns the character cell holding the dot at x, y
+func (c *brailleCanvas) cell(x, y float64) (col, row int, ok bool) {
+	col, row = int(math.Floor(x/2)), int(math.Floor(y/4))
+	return col, row, col >= 0 && row >= 0 && col < c.cols && row < c.rows
+}
+
+// write draws text from a cell rightwards, stopping at the edge or at text
+// already drawn, and reports whether the first character fit
+func (c *brailleCanvas) write(col, row int, s string, mark canvasMark) bool {
+	if row < 0 || row >= c.rows {
+		return false
+	}
+	for i, r := range []rune(s) {
+		if col+i < 0 || col+i >= c.cols || c.text[row][col+i] != 0 {
+			return i > 0
+		}
+		c.text[row][col+i] = r
+		c.marks[row][col+i] = mark
+	}
+	return true
+}
+
+// render returns the canvas as lines of text, styling marked characters
+func (c *brailleCanvas) render(styles map[canvasMark]lipgloss.Style) string {
+	lines := make([]string, c.rows)
+	for row := range c.dots {
+		var line, run strings.Builder
+		runMark := markNone
+		flush := func() {
+			if style, ok := styles[runMark]; ok && runMark != markNone {
+				line.WriteString(style.Render(run.String()))
+			} else {
+				line.WriteString(run.String())
+			}
+			run.Reset()
+		}
+
+		for col, dots := range c.dots[row] {
+			ch, mark := c.text[row][col], c.marks[row][col]
+			if ch == 0 {
+				ch, mark = ' ', markNone
+				if dots != 0 {
+					ch = 0x2800 + dots
+				}
+			}
+			if mark != runMark {
+				flush()
+				runMark = mark
+			}
+			run.WriteRune(ch)
+		}
+		flush()
+		lines[row] = line.String()
+	}
+	return strings.Join(lines, "\n")
+}
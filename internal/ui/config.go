@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// renderConfigView renders the device's Config and ModuleConfig sections,
+// highlighting settings that differ from the firmware defaults
+func (m Model) renderConfigView() string {
+	var sections []string
+	configSections := m.client.GetConfig().Sections()
+
+	var lines []string
+	changed := 0
+	for _, section := range configSections {
+		title := section.Name
+		if section.Module {
+			title += " (module)"
+		}
+		lines = append(lines, m.styles.Section.Render(title))
+		for _, field := range section.Fields {
+			line := fmt.Sprintf("  %-40s %s", field.Name, field.Value)
+			if field.Changed {
+				changed++
+				line = m.styles.Changed.Render(fmt.Sprintf("%s  (default %s)", line, field.Default))
+			}
+			lines = append(lines, line)
+		}
+		lines = append(lines, "")
+	}
+
+	// Header
+	sections = append(sections, m.styles.Header.Render(
+		fmt.Sprintf("Device Config (%d sections, %d changed from defaults)", len(configSections), changed),
+	))
+
+	if len(lines) == 0 {
+		sections = append(sections, m.styles.Details.Render("No config received from the device yet"))
+	} else {
+		// Scroll the settings to fit the screen
+		height := max(m.height-10, 5)
+		offset := min(m.configOffset, max(len(lines)-height, 0))
+		end := min(offset+height, len(lines))
+		sections = append(sections, m.styles.Stats.Render(strings.Join(lines[offset:end], "\n")))
+	}
+
+	// Help
+	sections = append(sections, m.styles.Help.Render("↑/↓: scroll • highlighted: changed from default • tab: switch view • q: quit"))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// scrollConfig moves the config view by delta lines
+func (m *Model) scrollConfig(delta int) {
+	lines := 0
+	for _, section := range m.client.GetConfig().Sections() {
+		lines += len(section.Fields) + 2
+	}
+	m.configOffset = max(min(m.configOffset+delta, lines-1), 0)
+}
@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go-mesh/internal/meshtastic"
+	"go-mesh/internal/utils"
+)
+
+// renderDeviceLogView renders the device's own log, newest lines at the bottom.
+// Scrolling moves the selected line; lines about a packet still in the
+// packet list are marked.
+func (m Model) renderDeviceLogView() string {
+	var sections []string
+	deviceLog := m.client.GetDeviceLog()
+	entries := deviceLog.Entries(m.deviceLogFilter())
+
+	// Header
+	level := "all levels"
+	if m.logLevel != meshtastic.LogLevelAll {
+		level = meshtastic.DeviceLogEntry{Level: m.logLevel}.LevelName() + " and above"
+	}
+	sections = append(sections, m.styles.Header.Render(
+		fmt.Sprintf("Device Log (%d of %d lines, %s)", len(entries), deviceLog.Len(), level),
+	))
+
+	// Search and status
+	if m.logSearchEditing {
+		sections = append(sections, m.styles.Filter.Render(fmt.Sprintf("Search: %s█", m.logSearch)))
+	} else if m.logSearch != "" {
+		sections = append(sections, m.styles.Filter.Render(fmt.Sprintf("Search: %s (/ to change)", m.logSearch)))
+	}
+	if m.logStatus != "" {
+		sections = append(sections, m.styles.Filter.Render(m.logStatus))
+	}
+
+	if len(entries) == 0 {
+		sections = append(sections, m.styles.Details.Render("No device log lines yet"))
+	} else {
+		// Show the newest lines that fit, or older ones once scrolled back
+		height := max(m.height-12, 5)
+		selected := max(len(entries)-1-m.logOffset, 0)
+		end := max(selected+1, min(height, len(entries)))
+		start := max(end-height, 0)
+
+		lines := make([]string, 0, end-start)
+		for i, entry := range entries[start:end] {
+			marker := "  "
+			if m.findLinkedPacket(entry) != nil {
+				marker = " •"
+			}
+			if start+i == selected {
+				marker = "▶" + marker[1:]
+			}
+			lines = append(lines, marker+" "+m.formatLogEntry(entry))
+		}
+		sections = append(sections, m.styles.Stats.Render(strings.Join(lines, "\n")))
+	}
+
+	// Help
+	sections = append(sections, m.styles.Help.Render("↑/↓: scroll • enter: linked packet • f: level • /: search • w: save • c: clear • tab: switch view • q: quit"))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// formatLogEntry formats a device log line, highlighting warnings and errors
+func (m Model) formatLogEntry(entry meshtastic.DeviceLogEntry) string {
+	line := fmt.Sprintf("%s %-5s", entry.Time.Format("15:04:05"), entry.LevelName())
+	if entry.Source != "" {
+		line += fmt.Sprintf(" [%s]", utils.SanitizeForTerminal(entry.Source))
+	}
+	line += " " + utils.SanitizeForTerminal(entry.Message)
+	if m.width > 10 {
+		line = utils.TruncateForDisplay(line, m.width-10)
+	}
+	if entry.Level >= meshtastic.LogLevelWarning {
+		return m.styles.Changed.Render(line)
+	}
+	return line
+}
+
+// formatRelatedLog formats the device log lines about a packet for the details view
+func (m Model) formatRelatedLog(entries []meshtastic.DeviceLogEntry) string {
+	const maxLines = 10
+	lines := []string{fmt.Sprintf("Device log (%d lines):", len(entries))}
+	if len(entries) > maxLines {
+		lines = append(lines, fmt.Sprintf("  ... %d earlier lines", len(entries)-maxLines))
+		entries = entries[len(entries)-maxLines:]
+	}
+	for _, entry := range entries {
+		lines = append(lines, "  "+m.formatLogEntry(entry))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// findLinkedPacket returns the newest packet in the list the log line is about, or nil
+func (m Model) findLinkedPacket(entry meshtastic.DeviceLogEntry) *meshtastic.Packet {
+	if entry.PacketID == 0 && len(entry.Nodes) == 0 {
+		return nil
+	}
+	for _, packet := range m.packets {
+		if entry.Mentions(packet, meshtastic.DefaultLogWindow) {
+			return packet
+		}
+	}
+	return nil
+}
+
+// showLogLinePacket opens the details of the packet the selected log line is about
+func (m *Model) showLogLinePacket() {
+	entries := m.client.GetDeviceLog().Entries(m.deviceLogFilter())
+	selected := max(len(entries)-1-m.logOffset, 0)
+	if selected >= len(entries) {
+		return
+	}
+	packet := m.findLinkedPacket(entries[selected])
+	if packet == nil {
+		m.logStatus = "No packet in the list matches the selected line"
+		return
+	}
+	m.linkedPacket = packet
+	m.currentView = ViewDetails
+}
+
+// deviceLogFilter returns the filter the device log view applies
+func (m Model) deviceLogFilter() meshtastic.DeviceLogFilter {
+	return meshtastic.DeviceLogFilter{MinLevel: m.logLevel, Search: m.logSearch}
+}
+
+// scrollDeviceLog moves the device log view by delta lines, negative for older lines
+func (m *Model) scrollDeviceLog(delta int) {
+	lines := len(m.client.GetDeviceLog().Entries(m.deviceLogFilter()))
+	m.logOffset = max(min(m.logOffset-delta, lines-1), 0)
+}
+
+// editLogSearch handles a key press while the device log search is being typed
+func (m *Model) editLogSearch(msg tea.KeyMsg) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.logSearchEditing = false
+	case tea.KeyEsc:
+		m.logSearchEditing = false
+		m.logSearch = ""
+	case tea.KeyBackspace:
+		if len(m.logSearch) > 0 {
+			runes := []rune(m.logSearch)
+			m.logSearch = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.logSearch += string(msg.Runes)
+	}
+	m.logOffset = 0
+}
+
+// saveDeviceLog writes the lines the view shows to a new timestamped file
+func (m *Model) saveDeviceLog() {
+	path := fmt.Sprintf("device-log-%s.txt", time.Now().Format("20060102-150405"))
+	n, err := m.client.GetDeviceLog().Save(path, m.deviceLogFilter())
+	if err != nil {
+		m.logStatus = fmt.Sprintf("Save failed: %v", err)
+		return
+	}
+	m.logStatus = fmt.Sprintf("Saved %d lines to %s", n, path)
+}
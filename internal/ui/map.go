@@ -0,0 +1,264 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"go-mesh/internal/meshtastic"
+	"go-mesh/internal/utils"
+)
+
+const (
+	// metersPerDegree is the length of a degree of latitude
+	metersPerDegree = 111320
+
+	// Limits of the map scale, in meters per braille dot
+	minMapScale = 1
+	maxMapScale = 100000
+
+	// maxRangeRings limits how many range rings are drawn around our node
+	maxRangeRings = 4
+)
+
+// mapViewport is the part of the world shown by the map view
+type mapViewport struct {
+	lat, lon      float64 // Centre in degrees
+	scale         float64 // Meters per dot
+	width, height int     // Canvas size in dots
+}
+
+// project returns the canvas position in dots of a point in degrees
+func (v mapViewport) project(lat, lon float64) (x, y float64) {
+	x = float64(v.width)/2 + (lon-v.lon)*math.Cos(v.lat*math.Pi/180)*metersPerDegree/v.scale
+	y = float64(v.height)/2 - (lat-v.lat)*metersPerDegree/v.scale
+	return x, y
+}
+
+// pan moves the centre by a fraction of the view's width and height
+func (v mapViewport) pan(dx, dy float64) mapViewport {
+	v.lat -= dy * float64(v.height) * v.scale / metersPerDegree
+	v.lon += dx * float64(v.width) * v.scale / (metersPerDegree * math.Max(math.Cos(v.lat*math.Pi/180), 0.01))
+	v.lat = math.Max(math.Min(v.lat, 90), -90)
+	return v
+}
+
+// mapPoint is a position plotted on the map
+type mapPoint struct {
+	lat, lon float64
+	label    string
+	mark     canvasMark
+}
+
+// renderMapView plots the last known position of every node, the waypoints
+// and the tracks nodes moved along on a braille canvas
+func (m Model) renderMapView() string {
+	var sections []string
+	points := m.mapPoints()
+
+	nodes := 0
+	for _, point := range points {
+		if point.mark != markWaypoint {
+			nodes++
+		}
+	}
+	sections = append(sections, m.styles.Header.Render(
+		fmt.Sprintf("Mesh Map (%d nodes, %d waypoints)", nodes, len(points)-nodes),
+	))
+
+	cols, rows := m.mapSize()
+	if len(points) == 0 {
+		sections = append(sections, m.styles.Details.Render(
+			"No positions received yet. Nodes appear once they send a position with a fix."))
+	} else {
+		viewport := m.mapViewport(points)
+		canvas := newBrailleCanvas(cols, rows)
+		rings := m.drawMap(canvas, viewport, points)
+
+		status := fmt.Sprintf("%.5f, %.5f • 1 dot = %s", viewport.lat, viewport.lon, formatDistance(viewport.scale))
+		if rings > 0 {
+			status += " • rings every " + formatDistance(rings)
+		}
+		if !m.mapManual {
+			status += " • fit to all positions"
+		}
+		sections = append(sections, m.styles.Filter.Render(status))
+		sections = append(sections, m.styles.Stats.Render(canvas.render(map[canvasMark]lipgloss.Style{
+			markSelf:     m.styles.Changed,
+			markWaypoint: m.styles.Section,
+			markRing:     m.styles.Help.UnsetPadding().UnsetMargins(),
+		})))
+	}
+
+	// Help
+	sections = append(sections, m.styles.Help.Render(
+		"◉ us ● node ◆ waypoint • arrows: pan • +/-: zoom • 0: fit all • r: range rings • tab: switch view • q: quit"))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// mapSize returns the canvas size in character cells for the window
+func (m Model) mapSize() (cols, rows int) {
+	return max(m.width-10, 20), max(m.height-16, 5)
+}
+
+// mapPoints returns the nodes with a position, our own first, and the
+// waypoints that haven't expired
+func (m Model) mapPoints() []mapPoint {
+	nodeDB := m.client.GetNodeDB()
+	me := m.client.GetMyNodeNum()
+
+	var points []mapPoint
+	for num, node := range nodeDB.GetAllNodes() {
+		if !node.HasPosition() {
+			continue
+		}
+		point := mapPoint{
+			lat:   meshtastic.GetLatitudeDegrees(node.Position),
+			lon:   meshtastic.GetLongitudeDegrees(node.Position),
+			label: utils.TruncateForDisplay(nodeDB.GetNodeShortName(num), 9),
+			mark:  markNode,
+		}
+		if num == me {
+			point.mark = markSelf
+			points = append([]mapPoint{point}, points...)
+		} else {
+			points = append(points, point)
+		}
+	}
+
+	for _, record := range m.client.GetWaypoints().List(m.client.Now()) {
+		if !record.HasPosition() {
+			continue
+		}
+		points = append(points, mapPoint{
+			lat:   meshtastic.GetLatitudeDegrees(record.Position()),
+			lon:   meshtastic.GetLongitudeDegrees(record.Position()),
+			label: utils.TruncateForDisplay(record.Waypoint.GetName(), 12),
+			mark:  markWaypoint,
+		})
+	}
+	return points
+}
+
+// mapViewport returns the panned and zoomed viewport, or one fitting every
+// point until the map has been moved
+func (m Model) mapViewport(points []mapPoint) mapViewport {
+	cols, rows := m.mapSize()
+	if m.mapManual {
+		return mapViewport{lat: m.mapLat, lon: m.mapLon, scale: m.mapScale, width: cols * 2, height: rows * 4}
+	}
+	return fitMapViewport(points, cols*2, rows*4)
+}
+
+// fitMapViewport centres the points and scales them to fill 90% of the canvas
+func fitMapViewport(points []mapPoint, width, height int) mapViewport {
+	v := mapViewport{scale: minMapScale * 10, width: width, height: height}
+	if len(points) == 0 {
+		return v
+	}
+
+	minLat, maxLat := points[0].lat, points[0].lat
+	minLon, maxLon := points[0].lon, points[0].lon
+	for _, point := range points[1:] {
+		minLat, maxLat = math.Min(minLat, point.lat), math.Max(maxLat, point.lat)
+		minLon, maxLon = math.Min(minLon, point.lon), math.Max(maxLon, point.lon)
+	}
+	v.lat, v.lon = (minLat+maxLat)/2, (minLon+maxLon)/2
+
+	spanX := (maxLon - minLon) * math.Cos(v.lat*math.Pi/180) * metersPerDegree
+	spanY := (maxLat - minLat) * metersPerDegree
+	v.scale = math.Max(v.scale, math.Max(spanX/(0.9*float64(width)), spanY/(0.9*float64(height))))
+	v.scale = math.Min(v.scale, maxMapScale)
+	return v
+}
+
+// drawMap draws range rings, tracks and points on the canvas and returns the
+// spacing of the rings in meters, 0 if none were drawn
+func (m Model) drawMap(canvas *brailleCanvas, viewport mapViewport, points []mapPoint) float64 {
+	// Rings around our node
+	var spacing, cx, cy float64
+	if m.mapRings && len(points) > 0 && points[0].mark == markSelf {
+		spacing = niceDistance(viewport.scale * float64(min(viewport.width, viewport.height)) / 2 / maxRangeRings)
+		cx, cy = viewport.project(points[0].lat, points[0].lon)
+		for ring := 1; ring <= maxRangeRings; ring++ {
+			drawCircle(canvas, cx, cy, float64(ring)*spacing/viewport.scale)
+		}
+	}
+
+	// Tracks from position history
+	history := m.client.GetPositionHistory()
+	for num := range m.client.GetNodeDB().GetAllNodes() {
+		track := history.Track(num)
+		for i := 1; i < len(track); i++ {
+			x0, y0 := viewport.project(track[i-1].Latitude, track[i-1].Longitude)
+			x1, y1 := viewport.project(track[i].Latitude, track[i].Longitude)
+			canvas.line(x0, y0, x1, y1)
+		}
+	}
+
+	// Markers before labels, so labels never hide a marker
+	cells := make([][2]int, len(points))
+	placed := make([]bool, len(points))
+	for i, point := range points {
+		col, row, ok := canvas.cell(viewport.project(point.lat, point.lon))
+		if !ok {
+			continue
+		}
+		cells[i] = [2]int{col, row}
+		marker := "●"
+		switch point.mark {
+		case markSelf:
+			marker = "◉"
+		case markWaypoint:
+			marker = "◆"
+		}
+		placed[i] = canvas.write(col, row, marker, point.mark)
+	}
+	for i, point := range points {
+		if placed[i] {
+			canvas.write(cells[i][0]+1, cells[i][1], point.label, point.mark)
+		}
+	}
+
+	// Ring distances at the top of each ring, where nothing else is drawn
+	for ring := 1; spacing > 0 && ring <= maxRangeRings; ring++ {
+		if col, row, ok := canvas.cell(cx, cy-float64(ring)*spacing/viewport.scale); ok {
+			canvas.write(col, row, formatDistance(float64(ring)*spacing), markRing)
+		}
+	}
+	return spacing
+}
+
+// drawCircle draws a circle with its centre and radius in dots
+func drawCircle(canvas *brailleCanvas, cx, cy, radius float64) {
+	segments := min(max(int(radius), 16), 720)
+	for i := 0; i < segments; i++ {
+		a0 := 2 * math.Pi * float64(i) / float64(segments)
+		a1 := 2 * math.Pi * float64(i+1) / float64(segments)
+		canvas.line(cx+radius*math.Cos(a0), cy+radius*math.Sin(a0), cx+radius*math.Cos(a1), cy+radius*math.Sin(a1))
+	}
+}
+
+// niceDistance rounds meters down to 1, 2 or 5 times a power of ten
+func niceDistance(meters float64) float64 {
+	if meters <= 0 {
+		return 1
+	}
+	magnitude := math.Pow(10, math.Floor(math.Log10(meters)))
+	for _, step := range []float64{5, 2, 1} {
+		if step*magnitude <= meters {
+			return step * magnitude
+		}
+	}
+	return magnitude
+}
+
+// moveMap pans the map by a fraction of its size and zooms by a factor,
+// keeping the current view as the starting point when leaving auto-fit
+func (m *Model) moveMap(dx, dy, zoom float64) {
+	viewport := m.mapViewport(m.mapPoints()).pan(dx, dy)
+	m.mapManual = true
+	m.mapLat, m.mapLon = viewport.lat, viewport.lon
+	m.mapScale = math.Min(math.Max(viewport.scale*zoom, minMapScale), maxMapScale)
+}
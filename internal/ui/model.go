@@ -1,642 +1,1301 @@
-package ui
-
-import (
-	"fmt"
-	"log"
-	"strings"
-	"time"
-
-	"github.com/charmbracelet/bubbles/help"
-	"github.com/charmbracelet/bubbles/key"
-	"github.com/charmbracelet/bubbles/table"
-	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
-	"go-mesh/internal/meshtastic"
-	"go-mesh/internal/utils"
-)
-
-// ViewMode represents the current view mode
-type ViewMode int
-
-const (
-	ViewPackets ViewMode = iota
-	ViewStatistics
-	ViewDetails
-	ViewHelp
-)
-
-// Model represents the main UI model
-type Model struct {
-	// Core components
-	client       *meshtastic.Client
-	logger       *log.Logger
-	filter       string
-	
-	// UI State
-	currentView  ViewMode
-	width        int
-	height       int
-	help         help.Model
-	keys         keyMap
-	
-	// Packet display
-	packets      []*meshtastic.Packet
-	packetTable  table.Model
-	selectedRow  int
-	
-	// Statistics
-	stats        *meshtastic.Statistics
-	
-	// Filters
-	filterActive bool
-	filterByType meshtastic.PacketType
-	filterByNode uint32
-	
-	// Packet messaging
-	packetChan   chan *meshtastic.Packet
-	
-	// Styles
-	styles       *Styles
-}
-
-// keyMap defines keyboard shortcuts
-type keyMap struct {
-	Up      key.Binding
-	Down    key.Binding
-	Left    key.Binding
-	Right   key.Binding
-	Help    key.Binding
-	Quit    key.Binding
-	Enter   key.Binding
-	Tab     key.Binding
-	Filter  key.Binding
-	Clear   key.Binding
-	Refresh key.Binding
-}
-
-// ShortHelp returns keybindings to be shown in the mini help view
-func (k keyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Help, k.Quit, k.Tab, k.Filter}
-}
-
-// FullHelp returns keybindings for the expanded help view
-func (k keyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{
-		{k.Up, k.Down, k.Left, k.Right},
-		{k.Enter, k.Tab, k.Filter, k.Clear},
-		{k.Refresh, k.Help, k.Quit},
-	}
-}
-
-var keys = keyMap{
-	Up: key.NewBinding(
-		key.WithKeys("up", "k"),
-		key.WithHelp("↑/k", "move up"),
-	),
-	Down: key.NewBinding(
-		key.WithKeys("down", "j"),
-		key.WithHelp("↓/j", "move down"),
-	),
-	Left: key.NewBinding(
-		key.WithKeys("left", "h"),
-		key.WithHelp("←/h", "move left"),
-	),
-	Right: key.NewBinding(
-		key.WithKeys("right", "l"),
-		key.WithHelp("→/l", "move right"),
-	),
-	Help: key.NewBinding(
-		key.WithKeys("?"),
-		key.WithHelp("?", "toggle help"),
-	),
-	Quit: key.NewBinding(
-		key.WithKeys("q", "esc", "ctrl+c"),
-		key.WithHelp("q", "quit"),
-	),
-	Enter: key.NewBinding(
-		key.WithKeys("enter"),
-		key.WithHelp("enter", "view details"),
-	),
-	Tab: key.NewBinding(
-		key.WithKeys("tab"),
-		key.WithHelp("tab", "switch view"),
-	),
-	Filter: key.NewBinding(
-		key.WithKeys("f"),
-		key.WithHelp("f", "toggle filter"),
-	),
-	Clear: key.NewBinding(
-		key.WithKeys("c"),
-		key.WithHelp("c", "clear packets"),
-	),
-	Refresh: key.NewBinding(
-		key.WithKeys("r"),
-		key.WithHelp("r", "refresh"),
-	),
-}
-
-// NewModel creates a new UI model
-func NewModel(client *meshtastic.Client, filter string, logger *log.Logger) Model {
-	// Create packet table
-	columns := []table.Column{
-		{Title: "Time", Width: 12},
-		{Title: "From", Width: 10},
-		{Title: "To", Width: 10},
-		{Title: "Type", Width: 12},
-		{Title: "Channel", Width: 7},
-		{Title: "Hops", Width: 6},
-		{Title: "RSSI", Width: 8},
-		{Title: "Data", Width: 30},
-	}
-
-	t := table.New(
-		table.WithColumns(columns),
-		table.WithFocused(true),
-		table.WithHeight(15),
-	)
-
-	model := Model{
-		client:      client,
-		logger:      logger,
-		filter:      filter,
-		currentView: ViewPackets,
-		help:        help.New(),
-		keys:        keys,
-		packets:     make([]*meshtastic.Packet, 0),
-		packetTable: t,
-		packetChan:  make(chan *meshtastic.Packet, 100),
-		styles:      NewStyles(),
-	}
-
-	// Subscribe to packet updates
-	client.SubscribeFunc(model.onPacketReceived)
-
-	return model
-}
-
-// Init initializes the model
-func (m Model) Init() tea.Cmd {
-	// Start the Meshtastic client
-	if err := m.client.Start(); err != nil {
-		m.logger.Printf("Failed to start Meshtastic client: %v", err)
-	}
-
-	return tea.Batch(
-		tea.EnterAltScreen,
-		tickCmd(),
-		listenForPacketsCmd(m.packetChan),
-	)
-}
-
-// Update handles messages and updates the model
-func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	var cmd tea.Cmd
-
-	switch msg := msg.(type) {
-	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
-		m.help.Width = msg.Width
-		m.updateTableSize()
-
-	case tea.KeyMsg:
-		switch {
-		case key.Matches(msg, m.keys.Quit):
-			return m, tea.Quit
-
-		case key.Matches(msg, m.keys.Help):
-			if m.currentView == ViewHelp {
-				m.currentView = ViewPackets
-			} else {
-				m.currentView = ViewHelp
-			}
-
-		case key.Matches(msg, m.keys.Tab):
-			m.nextView()
-
-		case key.Matches(msg, m.keys.Clear):
-			m.clearPackets()
-
-		case key.Matches(msg, m.keys.Filter):
-			m.filterActive = !m.filterActive
-
-		case key.Matches(msg, m.keys.Enter):
-			if m.currentView == ViewPackets && len(m.packets) > 0 {
-				m.currentView = ViewDetails
-			}
-
-		case key.Matches(msg, m.keys.Up, m.keys.Down):
-			if m.currentView == ViewPackets {
-				m.packetTable, cmd = m.packetTable.Update(msg)
-				m.selectedRow = m.packetTable.Cursor()
-			}
-		}
-
-	case tickMsg:
-		m.updateStats()
-		return m, tickCmd()
-
-	case packetMsg:
-		m.addPacket(msg.Packet)
-		cmd = listenForPacketsCmd(m.packetChan) // Continue listening
-	}
-
-	return m, cmd
-}
-
-// View renders the current view
-func (m Model) View() string {
-	if m.width == 0 {
-		return "Loading..."
-	}
-
-	switch m.currentView {
-	case ViewPackets:
-		return m.renderPacketsView()
-	case ViewStatistics:
-		return m.renderStatisticsView()
-	case ViewDetails:
-		return m.renderDetailsView()
-	case ViewHelp:
-		return m.renderHelpView()
-	default:
-		return "Unknown view"
-	}
-}
-
-// renderPacketsView renders the main packets view
-func (m Model) renderPacketsView() string {
-	var sections []string
-
-	// Header
-	connectionInfo := "Disconnected"
-	if m.client.IsConnected() {
-		connectionInfo = m.client.GetConnectionInfo()
-	}
-
-	header := m.styles.Header.Render(
-		fmt.Sprintf("Meshtastic Packet Debugger - %s", connectionInfo),
-	)
-	sections = append(sections, header)
-
-	// Connection status message
-	if len(m.packets) == 0 {
-		if m.client.IsConnected() {
-			statusMsg := "⏳ Connected and listening for packets..."
-			if connectionInfo != "" && strings.Contains(connectionInfo, "WiFi") {
-				statusMsg += "\n📡 Using HTTP polling (legacy firmware) - packets appear every ~5 seconds"
-			} else if strings.Contains(connectionInfo, "TCP") {
-				statusMsg += "\n📻 Listening for all RF traffic - packets will appear when mesh activity occurs"
-			} else if strings.Contains(connectionInfo, "Serial") {
-				statusMsg += "\n🔌 Serial connection active - waiting for data from device"
-			}
-			statusMsg += "\n\nPress 'q' to quit, '?' for help"
-			sections = append(sections, m.styles.Stats.Render(statusMsg))
-		} else {
-			sections = append(sections, m.styles.Stats.Render("❌ No connection established"))
-		}
-	}
-
-	// Filter status
-	if m.filterActive {
-		filterInfo := m.styles.Filter.Render("Filter: ACTIVE")
-		sections = append(sections, filterInfo)
-	}
-
-	// Packet table
-	sections = append(sections, m.styles.Table.Render(m.packetTable.View()))
-
-	// Statistics footer
-	stats := m.client.GetStatistics()
-	footer := m.styles.Footer.Render(
-		fmt.Sprintf("Total Packets: %d | Avg RSSI: %.1f dBm | Avg SNR: %.1f",
-			stats.TotalPackets, stats.AverageRSSI, stats.AverageSNR),
-	)
-	sections = append(sections, footer)
-
-	// Help
-	sections = append(sections, m.styles.Help.Render(m.help.ShortHelpView(m.keys.ShortHelp())))
-
-	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
-}
-
-// renderStatisticsView renders the statistics view
-func (m Model) renderStatisticsView() string {
-	stats := m.client.GetStatistics()
-	
-	var sections []string
-	
-	// Header
-	sections = append(sections, m.styles.Header.Render("Packet Statistics"))
-
-	// General stats
-	generalStats := fmt.Sprintf(`
-Total Packets: %d
-Average RSSI: %.1f dBm
-Average SNR: %.1f
-Uptime: %v
-Last Packet: %v
-`,
-		stats.TotalPackets,
-		stats.AverageRSSI,
-		stats.AverageSNR,
-		time.Since(stats.StartTime).Truncate(time.Second),
-		stats.LastPacketTime.Format("15:04:05"),
-	)
-	sections = append(sections, m.styles.Stats.Render(generalStats))
-
-	// Packets by type
-	typeStats := "Packets by Type:\n"
-	for packetType, count := range stats.PacketsByType {
-		typeStats += fmt.Sprintf("  %s: %d\n", meshtastic.PacketTypeNames[packetType], count)
-	}
-	sections = append(sections, m.styles.Stats.Render(typeStats))
-
-	// Packets by channel
-	channelStats := "Packets by Channel:\n"
-	for channel, count := range stats.PacketsByChannel {
-		channelStats += fmt.Sprintf("  Channel %d: %d\n", channel, count)
-	}
-	sections = append(sections, m.styles.Stats.Render(channelStats))
-	
-	// Packet detection statistics
-	detectionStats := "Packet Type Detection:\n"
-	detectionStats += meshtastic.GetGlobalPacketStats().GetStatsString()
-	sections = append(sections, m.styles.Stats.Render(detectionStats))
-
-	// Help
-	sections = append(sections, m.styles.Help.Render(m.help.ShortHelpView(m.keys.ShortHelp())))
-
-	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
-}
-
-// renderDetailsView renders the packet details view
-func (m Model) renderDetailsView() string {
-	var sections []string
-	
-	// Header
-	sections = append(sections, m.styles.Header.Render("Packet Details"))
-
-	if m.selectedRow >= 0 && m.selectedRow < len(m.packets) {
-		packet := m.packets[m.selectedRow]
-		nodeDB := m.client.GetNodeDB()
-		
-		details := fmt.Sprintf(`
-ID: %d
-From: %s (%s)
-To: %s (%s)
-Type: %s
-Channel: %d
-Hops: %s
-Signal: %s
-Time: %s
-
-Raw Data (%d bytes):
-%x
-
-Decoded Data:
-%v
-`,
-			packet.ID,
-			packet.GetFromName(nodeDB), packet.GetFromHex(),
-			packet.GetToName(nodeDB), packet.GetToHex(),
-			packet.GetTypeName(),
-			packet.Channel,
-			packet.GetHopInfo(),
-			packet.GetSignalStrength(),
-			packet.RxTime.Format("15:04:05"),
-			len(packet.Raw),
-			packet.Raw,
-			packet.DecodedData,
-		)
-		
-		sections = append(sections, m.styles.Details.Render(details))
-	} else {
-		sections = append(sections, m.styles.Details.Render("No packet selected"))
-	}
-
-	// Help
-	sections = append(sections, m.styles.Help.Render(m.help.ShortHelpView(m.keys.ShortHelp())))
-
-	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
-}
-
-// renderHelpView renders the help view
-func (m Model) renderHelpView() string {
-	var sections []string
-	
-	// Header
-	sections = append(sections, m.styles.Header.Render("Help"))
-
-	// Full help
-	sections = append(sections, m.styles.Help.Render(m.help.FullHelpView(m.keys.FullHelp())))
-
-	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
-}
-
-// Helper methods
-
-func (m *Model) nextView() {
-	m.currentView = (m.currentView + 1) % 4
-}
-
-func (m *Model) updateTableSize() {
-	m.packetTable.SetWidth(m.width - 4)
-	m.packetTable.SetHeight(m.height - 10)
-}
-
-func (m *Model) updateStats() {
-	m.stats = m.client.GetStatistics()
-}
-
-func (m *Model) clearPackets() {
-	m.packets = make([]*meshtastic.Packet, 0)
-	m.updatePacketTable()
-}
-
-func (m *Model) addPacket(packet *meshtastic.Packet) {
-	// Apply filters if active
-	if m.filterActive {
-		if m.filterByType != 0 && packet.Type != m.filterByType {
-			return
-		}
-		if m.filterByNode != 0 && packet.From != m.filterByNode {
-			return
-		}
-	}
-
-	// Add packet to the beginning of the list
-	m.packets = append([]*meshtastic.Packet{packet}, m.packets...)
-	
-	// Limit to last 1000 packets
-	if len(m.packets) > 1000 {
-		m.packets = m.packets[:1000]
-	}
-	
-	m.updatePacketTable()
-}
-
-func (m *Model) updatePacketTable() {
-	var rows []table.Row
-	
-	for _, packet := range m.packets {
-		data := ""
-		if packet.DecodedData != nil {
-			switch d := packet.DecodedData.(type) {
-			case *meshtastic.TextData:
-				// Show enhanced information for categorized text
-				if d.Category != "general" && d.Category != "" {
-					// For device startup info, show category and key details
-					data = strings.ToUpper(d.Category)
-					if len(d.Details) > 0 {
-						// Show the most relevant detail
-						for key, value := range d.Details {
-							if key == "owner" || key == "user" || key == "name" ||
-							   key == "firmware" || key == "version" || key == "channel" {
-								data += fmt.Sprintf(": %s", value)
-								break
-							}
-						}
-					}
-				} else {
-					data = d.Text
-				}
-			case *meshtastic.PositionData:
-				lat := meshtastic.GetLatitudeDegrees(d)
-				lon := meshtastic.GetLongitudeDegrees(d)
-				if lat == 0 && lon == 0 {
-					// Check if coordinates are actually missing (nil pointers) or zero
-					if d.LatitudeI == nil && d.LongitudeI == nil {
-						data = "GPS: No fix (no data)"
-					} else {
-						data = "GPS: No fix (0,0)"
-					}
-				} else {
-					data = fmt.Sprintf("Lat:%.4f Lon:%.4f", lat, lon)
-				}
-			case *meshtastic.TelemetryData:
-				if d.DeviceMetrics != nil {
-					data = fmt.Sprintf("Batt:%d%% V:%.2f Ch:%.1f%% Up:%ds", 
-						d.DeviceMetrics.BatteryLevel, d.DeviceMetrics.Voltage,
-						d.DeviceMetrics.ChannelUtilization, d.DeviceMetrics.UptimeSeconds)
-				} else if d.EnvironmentMetrics != nil {
-					data = fmt.Sprintf("Temp:%.1f°C Hum:%.1f%% Press:%.1fhPa",
-						d.EnvironmentMetrics.Temperature, d.EnvironmentMetrics.RelativeHumidity,
-						d.EnvironmentMetrics.BarometricPressure)
-				} else {
-					data = "Telemetry"
-				}
-			case *meshtastic.RemoteHardwareMessage:
-				data = fmt.Sprintf("%s: %s", d.Type.GetTypeName(), d.FormatGpioInfo())
-			case *meshtastic.NodeInfo:
-				if d.LongName != "" {
-					// Sanitize usernames to prevent terminal display issues
-					sanitizedLongName := utils.SanitizeForTerminal(d.LongName)
-					sanitizedShortName := utils.SanitizeForTerminal(d.ShortName)
-					data = fmt.Sprintf("%s (%s)", sanitizedLongName, sanitizedShortName)
-				} else if d.ShortName != "" {
-					data = utils.SanitizeForTerminal(d.ShortName)
-				} else if d.ID != "" {
-					data = d.ID
-				} else {
-					data = "Node Info"
-				}
-			}
-		} else {
-			// For unknown packets, show first few bytes of payload as hex
-			if len(packet.Payload) > 0 {
-				hexStr := ""
-				maxBytes := len(packet.Payload)
-				if maxBytes > 8 { // Limit to first 8 bytes for display
-					maxBytes = 8
-				}
-				for i := 0; i < maxBytes; i++ {
-					hexStr += fmt.Sprintf("%02x", packet.Payload[i])
-				}
-				if len(packet.Payload) > 8 {
-					hexStr += "..."
-				}
-				data = fmt.Sprintf("Raw: %s (%d bytes)", hexStr, len(packet.Payload))
-			} else {
-				data = "Empty payload"
-			}
-		}
-		
-		if len(data) > 25 {
-			data = data[:25] + "..."
-		}
-
-		// Format display based on packet type - use friendly names from NodeDB
-		nodeDB := m.client.GetNodeDB()
-		
-		fromDisplay := packet.GetFromShortName(nodeDB)
-		toDisplay := packet.GetToShortName(nodeDB)
-		
-		// Sanitize and truncate long names to fit in column width
-		fromDisplay = utils.TruncateForDisplay(fromDisplay, 9)
-		toDisplay = utils.TruncateForDisplay(toDisplay, 9)
-		
-		hopDisplay := packet.GetHopInfo()
-		rssiDisplay := fmt.Sprintf("%.0f", float64(packet.RxRSSI))
-		
-		// Special formatting for device/CLI messages
-		if packet.From == 0 && packet.To == 0xFFFFFFFF && packet.RxRSSI == 0 {
-			fromDisplay = "DEVICE"
-			toDisplay = "CLI"
-			hopDisplay = "-"
-			rssiDisplay = "-"
-		}
-		
-		row := table.Row{
-			packet.RxTime.Format("15:04:05"),
-			fromDisplay,
-			toDisplay,
-			packet.GetTypeName(),
-			fmt.Sprintf("%d", packet.Channel),
-			hopDisplay,
-			rssiDisplay,
-			data,
-		}
-		rows = append(rows, row)
-	}
-	
-	m.packetTable.SetRows(rows)
-}
-
-func (m *Model) onPacketReceived(packet *meshtastic.Packet) {
-	// This will be called from a goroutine, so we need to send a message
-	// to the main update loop via the packet channel
-	select {
-	case m.packetChan <- packet:
-		// Successfully queued for UI processing
-	default:
-		// Channel is full, drop packet to avoid blocking
-		m.logger.Println("Packet channel full, dropping packet for UI")
-	}
-}
-
-// Messages
-
-type tickMsg struct{}
-
-// packetMsg wraps a Meshtastic packet for Bubble Tea's update loop
-type packetMsg struct {
-	Packet *meshtastic.Packet
-}
-
-// listenForPacketsCmd listens on a channel and emits packetMsg into Bubble Tea's loop
-func listenForPacketsCmd(ch <-chan *meshtastic.Packet) tea.Cmd {
-	return func() tea.Msg {
-		p, ok := <-ch
-		if !ok {
-			return nil
-		}
-		return packetMsg{Packet: p}
-	}
-}
-
-func tickCmd() tea.Cmd {
-	return tea.Tick(time.Second*1, func(t time.Time) tea.Msg {
-		return tickMsg{}
-	})
-}
+package ui
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go-mesh/internal/meshtastic"
+	"go-mesh/internal/pcap"
+	"go-mesh/internal/utils"
+)
+
+// ViewMode represents the current view mode
+type ViewMode int
+
+const (
+	ViewPackets ViewMode = iota
+	ViewNodes
+	ViewStatistics
+	ViewConfig
+	ViewDeviceLog
+	ViewTopology
+	ViewWaypoints
+	ViewMap
+	ViewDetails
+	ViewHelp
+	ViewTraceroute
+)
+
+// maxTracerouteHistory limits how many traceroute runs are kept for comparison
+const maxTracerouteHistory = 10
+
+// Model represents the main UI model
+type Model struct {
+	// Core components
+	client       *meshtastic.Client
+	logger       *log.Logger
+	filter       string
+	
+	// UI State
+	currentView  ViewMode
+	width        int
+	height       int
+	help         help.Model
+	keys         keyMap
+	
+	// Packet display
+	packets      []*meshtastic.Packet
+	visible      []*meshtastic.Packet // packets passing the active filter, as shown in the table
+	packetTable  table.Model
+	selectedRow  int
+	
+	// Node display
+	nodeTable         table.Model
+	nodeRows          []uint32 // node numbers in table order
+	nodeSort          nodeSortMode
+	nodeFilter        string
+	nodeFilterEditing bool
+	
+	// Statistics
+	stats        *meshtastic.Statistics
+	
+	// Config view scroll position, in lines
+	configOffset int
+	
+	// Device log view
+	logLevel         meshtastic.LogLevel
+	logSearch        string
+	logSearchEditing bool
+	logOffset        int // lines scrolled back from the newest
+	logStatus        string
+	linkedPacket     *meshtastic.Packet // shown by the details view when opened from a log line
+	
+	// Topology view
+	topologyOffset int
+	topologyStatus string
+	
+	// Waypoints view scroll position, in lines
+	waypointOffset int
+	
+	// Map view, fitted to every position until panned or zoomed
+	mapManual bool
+	mapLat    float64
+	mapLon    float64
+	mapScale  float64 // meters per braille dot
+	mapRings  bool
+	
+	// Filters
+	filterActive bool
+	filterByType meshtastic.PacketType
+	filterByNode uint32
+	
+	// Traceroute runs, newest first
+	traceTarget  uint32
+	traceRunning bool
+	traceHistory []tracerouteMsg
+	
+	// pcapng export, started and stopped with the Export key
+	exporter  *pcap.Exporter
+	exportErr error
+	
+	// Packet messaging
+	packetChan   chan *meshtastic.Packet
+	deliveryChan chan meshtastic.Delivery
+	
+	// Styles
+	styles       *Styles
+}
+
+// keyMap defines keyboard shortcuts
+type keyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Left    key.Binding
+	Right   key.Binding
+	Help    key.Binding
+	Quit    key.Binding
+	Enter   key.Binding
+	Tab     key.Binding
+	Filter  key.Binding
+	Clear   key.Binding
+	Refresh key.Binding
+	Trace   key.Binding
+	Nodes   key.Binding
+	Sort    key.Binding
+	Search  key.Binding
+	Pause   key.Binding
+	Step    key.Binding
+	Export  key.Binding
+	SaveLog key.Binding
+	Topo    key.Binding
+	Config  key.Binding
+	Log     key.Binding
+	Graph   key.Binding
+	Points  key.Binding
+	Map     key.Binding
+	ZoomIn  key.Binding
+	ZoomOut key.Binding
+	Fit     key.Binding
+	Rings   key.Binding
+}
+
+// ShortHelp returns keybindings to be shown in the mini help view
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Help, k.Quit, k.Tab, k.Filter}
+}
+
+// FullHelp returns keybindings for the expanded help view
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Left, k.Right},
+		{k.Enter, k.Tab, k.Filter, k.Clear},
+		{k.Nodes, k.Sort, k.Search, k.Config, k.Log, k.Graph, k.Points},
+		{k.Map, k.ZoomIn, k.ZoomOut, k.Fit, k.Rings},
+		{k.Pause, k.Step},
+		{k.Trace, k.Export, k.SaveLog, k.Topo, k.Refresh, k.Help, k.Quit},
+	}
+}
+
+var keys = keyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "move up"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "move down"),
+	),
+	Left: key.NewBinding(
+		key.WithKeys("left", "h"),
+		key.WithHelp("←/h", "move left"),
+	),
+	Right: key.NewBinding(
+		key.WithKeys("right", "l"),
+		key.WithHelp("→/l", "move right"),
+	),
+	Help: key.NewBinding(
+		key.WithKeys("?"),
+		key.WithHelp("?", "toggle help"),
+	),
+	Quit: key.NewBinding(
+		key.WithKeys("q", "esc", "ctrl+c"),
+		key.WithHelp("q", "quit"),
+	),
+	Enter: key.NewBinding(
+		key.WithKeys("enter"),
+		key.WithHelp("enter", "view details"),
+	),
+	Tab: key.NewBinding(
+		key.WithKeys("tab"),
+		key.WithHelp("tab", "switch view"),
+	),
+	Filter: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "toggle filter"),
+	),
+	Clear: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "clear packets"),
+	),
+	Refresh: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "refresh"),
+	),
+	Trace: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "traceroute to node"),
+	),
+	Nodes: key.NewBinding(
+		key.WithKeys("n"),
+		key.WithHelp("n", "nodes view"),
+	),
+	Sort: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "sort nodes"),
+	),
+	Search: key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "filter nodes"),
+	),
+	Pause: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "pause/resume replay"),
+	),
+	Step: key.NewBinding(
+		key.WithKeys("."),
+		key.WithHelp(".", "step replay"),
+	),
+	Export: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "start/stop pcap export"),
+	),
+	SaveLog: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "save device log (log view)"),
+	),
+	Topo: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "export topology (topology view)"),
+	),
+	Config: key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "device config view"),
+	),
+	Log: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "device log view"),
+	),
+	Graph: key.NewBinding(
+		key.WithKeys("o"),
+		key.WithHelp("o", "mesh topology view"),
+	),
+	Points: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "waypoints view"),
+	),
+	Map: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "map view"),
+	),
+	ZoomIn: key.NewBinding(
+		key.WithKeys("+", "="),
+		key.WithHelp("+", "zoom in"),
+	),
+	ZoomOut: key.NewBinding(
+		key.WithKeys("-"),
+		key.WithHelp("-", "zoom out"),
+	),
+	Fit: key.NewBinding(
+		key.WithKeys("0"),
+		key.WithHelp("0", "fit map"),
+	),
+	Rings: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "range rings (map view)"),
+	),
+}
+
+// NewModel creates a new UI model
+func NewModel(client *meshtastic.Client, exporter *pcap.Exporter, filter string, logger *log.Logger) Model {
+	// Create packet table
+	columns := []table.Column{
+		{Title: "Time", Width: 12},
+		{Title: "From", Width: 10},
+		{Title: "To", Width: 10},
+		{Title: "Type", Width: 12},
+		{Title: "Channel", Width: 10},
+		{Title: "Hops", Width: 6},
+		{Title: "RSSI", Width: 8},
+		{Title: "Ack", Width: 12},
+		{Title: "Data", Width: 30},
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+
+	model := Model{
+		client:       client,
+		logger:       logger,
+		filter:       filter,
+		currentView:  ViewPackets,
+		help:         help.New(),
+		keys:         keys,
+		packets:      make([]*meshtastic.Packet, 0),
+		packetTable:  t,
+		nodeTable:    newNodeTable(),
+		exporter:     exporter,
+		packetChan:   make(chan *meshtastic.Packet, 100),
+		deliveryChan: make(chan meshtastic.Delivery, 100),
+		styles:       NewStyles(),
+	}
+
+	// Subscribe to packet updates
+	client.SubscribeFunc(model.onPacketReceived)
+	client.SubscribeDeliveryFunc(model.onDeliveryUpdated)
+
+	return model
+}
+
+// Init initializes the model
+func (m Model) Init() tea.Cmd {
+	// Start the Meshtastic client
+	if err := m.client.Start(); err != nil {
+		m.logger.Printf("Failed to start Meshtastic client: %v", err)
+	}
+
+	return tea.Batch(
+		tea.EnterAltScreen,
+		tickCmd(),
+		listenForPacketsCmd(m.packetChan),
+		listenForDeliveriesCmd(m.deliveryChan),
+	)
+}
+
+// Update handles messages and updates the model
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.help.Width = msg.Width
+		m.updateTableSize()
+
+	case tea.KeyMsg:
+		// The node filter captures all keys while it is being typed
+		if m.nodeFilterEditing {
+			m.editNodeFilter(msg)
+			return m, nil
+		}
+		if m.logSearchEditing {
+			m.editLogSearch(msg)
+			return m, nil
+		}
+
+		switch {
+		case key.Matches(msg, m.keys.Quit):
+			return m, tea.Quit
+
+		case key.Matches(msg, m.keys.Help):
+			if m.currentView == ViewHelp {
+				m.currentView = ViewPackets
+			} else {
+				m.currentView = ViewHelp
+			}
+
+		case key.Matches(msg, m.keys.Tab):
+			m.nextView()
+
+		case key.Matches(msg, m.keys.Clear):
+			if m.currentView == ViewDeviceLog {
+				m.client.GetDeviceLog().Clear()
+				m.logOffset = 0
+			} else {
+				m.clearPackets()
+			}
+
+		case key.Matches(msg, m.keys.Filter) && m.currentView == ViewDeviceLog:
+			m.logLevel = meshtastic.NextLogLevel(m.logLevel)
+			m.logOffset = 0
+
+		case key.Matches(msg, m.keys.Filter):
+			m.filterActive = !m.filterActive
+			if !m.filterActive {
+				m.filterByNode = 0
+			}
+			m.updatePacketTable()
+
+		case key.Matches(msg, m.keys.Enter):
+			if m.currentView == ViewPackets && len(m.visible) > 0 {
+				m.linkedPacket = nil
+				m.currentView = ViewDetails
+			} else if m.currentView == ViewNodes {
+				m.showSelectedNodePackets()
+			} else if m.currentView == ViewDeviceLog {
+				m.showLogLinePacket()
+			}
+
+		case key.Matches(msg, m.keys.Nodes):
+			m.currentView = ViewNodes
+			m.updateNodeTable()
+
+		case key.Matches(msg, m.keys.Config):
+			m.currentView = ViewConfig
+
+		case key.Matches(msg, m.keys.Log):
+			m.currentView = ViewDeviceLog
+
+		case key.Matches(msg, m.keys.Graph):
+			m.currentView = ViewTopology
+
+		case key.Matches(msg, m.keys.Points):
+			m.currentView = ViewWaypoints
+
+		case key.Matches(msg, m.keys.Map):
+			m.currentView = ViewMap
+
+		case key.Matches(msg, m.keys.ZoomIn) && m.currentView == ViewMap:
+			m.moveMap(0, 0, 0.5)
+
+		case key.Matches(msg, m.keys.ZoomOut) && m.currentView == ViewMap:
+			m.moveMap(0, 0, 2)
+
+		case key.Matches(msg, m.keys.Fit) && m.currentView == ViewMap:
+			m.mapManual = false
+
+		case key.Matches(msg, m.keys.Rings) && m.currentView == ViewMap:
+			m.mapRings = !m.mapRings
+
+		case key.Matches(msg, m.keys.Sort):
+			if m.currentView == ViewNodes {
+				m.nodeSort = (m.nodeSort + 1) % nodeSortModeCount
+				m.updateNodeTable()
+			}
+
+		case key.Matches(msg, m.keys.Search):
+			if m.currentView == ViewNodes {
+				m.nodeFilterEditing = true
+			} else if m.currentView == ViewDeviceLog {
+				m.logSearchEditing = true
+			}
+
+		case key.Matches(msg, m.keys.Trace):
+			cmd = m.startTraceroute()
+
+		case key.Matches(msg, m.keys.Pause):
+			if control, ok := m.client.GetReplayControl(); ok {
+				if control.Progress().Paused {
+					control.Resume()
+				} else {
+					control.Pause()
+				}
+			}
+
+		case key.Matches(msg, m.keys.SaveLog) && m.currentView == ViewDeviceLog:
+			m.saveDeviceLog()
+
+		case key.Matches(msg, m.keys.Topo) && m.currentView == ViewTopology:
+			m.exportTopology()
+
+		case key.Matches(msg, m.keys.Export):
+			m.toggleExport()
+
+		case key.Matches(msg, m.keys.Step):
+			if control, ok := m.client.GetReplayControl(); ok {
+				control.Pause()
+				control.Step()
+			}
+
+		case key.Matches(msg, m.keys.Up, m.keys.Down, m.keys.Left, m.keys.Right) && m.currentView == ViewMap:
+			switch {
+			case key.Matches(msg, m.keys.Up):
+				m.moveMap(0, -0.25, 1)
+			case key.Matches(msg, m.keys.Down):
+				m.moveMap(0, 0.25, 1)
+			case key.Matches(msg, m.keys.Left):
+				m.moveMap(-0.25, 0, 1)
+			default:
+				m.moveMap(0.25, 0, 1)
+			}
+
+		case key.Matches(msg, m.keys.Up, m.keys.Down):
+			if m.currentView == ViewPackets {
+				m.packetTable, cmd = m.packetTable.Update(msg)
+				m.selectedRow = m.packetTable.Cursor()
+			} else if m.currentView == ViewNodes {
+				m.nodeTable, cmd = m.nodeTable.Update(msg)
+			} else if m.currentView == ViewConfig {
+				if key.Matches(msg, m.keys.Up) {
+					m.scrollConfig(-1)
+				} else {
+					m.scrollConfig(1)
+				}
+			} else if m.currentView == ViewDeviceLog {
+				if key.Matches(msg, m.keys.Up) {
+					m.scrollDeviceLog(-1)
+				} else {
+					m.scrollDeviceLog(1)
+				}
+			} else if m.currentView == ViewTopology {
+				if key.Matches(msg, m.keys.Up) {
+					m.scrollTopology(-1)
+				} else {
+					m.scrollTopology(1)
+				}
+			} else if m.currentView == ViewWaypoints {
+				if key.Matches(msg, m.keys.Up) {
+					m.scrollWaypoints(-1)
+				} else {
+					m.scrollWaypoints(1)
+				}
+			}
+		}
+
+	case tickMsg:
+		m.updateStats()
+		if m.currentView == ViewNodes {
+			// Keep "last heard" ages and new nodes current
+			m.updateNodeTable()
+		}
+		return m, tickCmd()
+
+	case packetMsg:
+		m.addPacket(msg.Packet)
+		cmd = listenForPacketsCmd(m.packetChan) // Continue listening
+
+	case tracerouteMsg:
+		m.traceRunning = false
+		m.traceHistory = append([]tracerouteMsg{msg}, m.traceHistory...)
+		if len(m.traceHistory) > maxTracerouteHistory {
+			m.traceHistory = m.traceHistory[:maxTracerouteHistory]
+		}
+
+	case deliveryMsg:
+		// Refresh the Ack column of the sent packet
+		m.updatePacketTable()
+		cmd = listenForDeliveriesCmd(m.deliveryChan)
+	}
+
+	return m, cmd
+}
+
+// View renders the current view
+func (m Model) View() string {
+	if m.width == 0 {
+		return "Loading..."
+	}
+
+	switch m.currentView {
+	case ViewPackets:
+		return m.renderPacketsView()
+	case ViewNodes:
+		return m.renderNodesView()
+	case ViewStatistics:
+		return m.renderStatisticsView()
+	case ViewConfig:
+		return m.renderConfigView()
+	case ViewDeviceLog:
+		return m.renderDeviceLogView()
+	case ViewTopology:
+		return m.renderTopologyView()
+	case ViewWaypoints:
+		return m.renderWaypointsView()
+	case ViewMap:
+		return m.renderMapView()
+	case ViewDetails:
+		return m.renderDetailsView()
+	case ViewHelp:
+		return m.renderHelpView()
+	case ViewTraceroute:
+		return m.renderTracerouteView()
+	default:
+		return "Unknown view"
+	}
+}
+
+// renderPacketsView renders the main packets view
+func (m Model) renderPacketsView() string {
+	var sections []string
+
+	// Header
+	connectionInfo := "Disconnected"
+	if m.client.IsConnected() {
+		connectionInfo = m.client.GetConnectionInfo()
+	}
+
+	header := m.styles.Header.Render(
+		fmt.Sprintf("Meshtastic Packet Debugger - %s", connectionInfo),
+	)
+	sections = append(sections, header)
+
+	// Link lost and reconnect events
+	if event, reconnects, ok := m.client.GetLinkStatus(); ok {
+		sections = append(sections, m.styles.Filter.Render(formatLinkStatus(event, reconnects)))
+	}
+
+	// Replay position
+	if control, ok := m.client.GetReplayControl(); ok {
+		sections = append(sections, m.styles.Filter.Render(formatReplayProgress(control.Progress())))
+	}
+
+	// Connection status message
+	if len(m.packets) == 0 {
+		if m.client.IsConnected() {
+			statusMsg := "⏳ Connected and listening for packets..."
+			if connectionInfo != "" && strings.Contains(connectionInfo, "WiFi") {
+				statusMsg += "\n📡 Using HTTP polling (legacy firmware) - packets appear every ~5 seconds"
+			} else if strings.Contains(connectionInfo, "TCP") {
+				statusMsg += "\n📻 Listening for all RF traffic - packets will appear when mesh activity occurs"
+			} else if strings.Contains(connectionInfo, "Serial") {
+				statusMsg += "\n🔌 Serial connection active - waiting for data from device"
+			}
+			statusMsg += "\n\nPress 'q' to quit, '?' for help"
+			sections = append(sections, m.styles.Stats.Render(statusMsg))
+		} else {
+			sections = append(sections, m.styles.Stats.Render("❌ No connection established"))
+		}
+	}
+
+	// Filter status
+	if m.filterActive {
+		filterText := "Filter: ACTIVE"
+		if m.filterByNode != 0 {
+			filterText = fmt.Sprintf("Filter: packets from or to %s (f to clear)", m.client.GetNodeName(m.filterByNode))
+		}
+		filterInfo := m.styles.Filter.Render(filterText)
+		sections = append(sections, filterInfo)
+	}
+
+	// pcapng export status
+	if path, packets, active := m.exporter.Status(); active {
+		sections = append(sections, m.styles.Filter.Render(
+			fmt.Sprintf("Exporting to %s: %d packets (w to stop)", path, packets),
+		))
+	} else if m.exportErr != nil {
+		sections = append(sections, m.styles.Filter.Render(fmt.Sprintf("pcap export: %v", m.exportErr)))
+	}
+
+	// Packet table
+	sections = append(sections, m.styles.Table.Render(m.packetTable.View()))
+
+	// Statistics footer
+	stats := m.client.GetStatistics()
+	footerText := fmt.Sprintf("Total Packets: %d | Avg RSSI: %.1f dBm | Avg SNR: %.1f",
+		stats.TotalPackets, stats.AverageRSSI, stats.AverageSNR)
+	if progress := formatConfigProgress(m.client.GetConfigProgress()); progress != "" {
+		footerText = progress + " | " + footerText
+	}
+	sections = append(sections, m.styles.Footer.Render(footerText))
+
+	// Help
+	sections = append(sections, m.styles.Help.Render(m.help.ShortHelpView(m.keys.ShortHelp())))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// renderStatisticsView renders the statistics view
+func (m Model) renderStatisticsView() string {
+	stats := m.client.GetStatistics()
+	
+	var sections []string
+	
+	// Header
+	sections = append(sections, m.styles.Header.Render("Packet Statistics"))
+
+	// General stats
+	generalStats := fmt.Sprintf(`
+Total Packets: %d
+Average RSSI: %.1f dBm
+Average SNR: %.1f
+Uptime: %v
+Last Packet: %v
+`,
+		stats.TotalPackets,
+		stats.AverageRSSI,
+		stats.AverageSNR,
+		m.client.Now().Sub(stats.StartTime).Truncate(time.Second),
+		stats.LastPacketTime.Format("15:04:05"),
+	)
+	sections = append(sections, m.styles.Stats.Render(generalStats))
+
+	// Packets by type
+	typeStats := "Packets by Type:\n"
+	for packetType, count := range stats.PacketsByType {
+		typeStats += fmt.Sprintf("  %s: %d\n", meshtastic.PacketTypeNames[packetType], count)
+	}
+	sections = append(sections, m.styles.Stats.Render(typeStats))
+
+	// Packets by channel
+	channelStats := "Packets by Channel:\n"
+	for channel, count := range stats.PacketsByChannel {
+		channelStats += fmt.Sprintf("  Channel %d: %d\n", channel, count)
+	}
+	sections = append(sections, m.styles.Stats.Render(channelStats))
+	
+	// Packet detection statistics
+	detectionStats := "Packet Type Detection:\n"
+	detectionStats += meshtastic.GetGlobalPacketStats().GetStatsString()
+	sections = append(sections, m.styles.Stats.Render(detectionStats))
+
+	// Help
+	sections = append(sections, m.styles.Help.Render(m.help.ShortHelpView(m.keys.ShortHelp())))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// renderDetailsView renders the packet details view
+func (m Model) renderDetailsView() string {
+	var sections []string
+	
+	// Header
+	sections = append(sections, m.styles.Header.Render("Packet Details"))
+
+	if packet := m.selectedPacket(); packet != nil {
+		nodeDB := m.client.GetNodeDB()
+		
+		details := fmt.Sprintf(`
+ID: %d
+From: %s (%s)
+To: %s (%s)
+Type: %s
+Channel: %s
+Hops: %s
+Signal: %s
+Time: %s
+Encryption: %s
+
+Raw Data (%d bytes):
+%x
+
+Decoded Data:
+%v
+`,
+			packet.ID,
+			packet.GetFromName(nodeDB), packet.GetFromHex(),
+			packet.GetToName(nodeDB), packet.GetToHex(),
+			packet.GetTypeName(),
+			m.formatChannel(packet),
+			packet.GetHopInfo(),
+			packet.GetSignalStrength(),
+			packet.RxTime.Format("15:04:05"),
+			formatEncryption(packet),
+			len(packet.Raw),
+			packet.Raw,
+			packet.DecodedData,
+		)
+		
+		sections = append(sections, m.styles.Details.Render(details))
+		if packet.KeyWarning != "" {
+			sections = append(sections, m.styles.Filter.Render("⚠ Key mismatch: "+packet.KeyWarning))
+		}
+		
+		// The firmware's own log lines about the packet, e.g. dup drops and rebroadcasts
+		if related := m.client.GetDeviceLog().Related(packet, meshtastic.DefaultLogWindow); len(related) > 0 {
+			sections = append(sections, m.styles.Stats.Render(m.formatRelatedLog(related)))
+		}
+	} else {
+		sections = append(sections, m.styles.Details.Render("No packet selected"))
+	}
+
+	// Help
+	sections = append(sections, m.styles.Help.Render(m.help.ShortHelpView(m.keys.ShortHelp())))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// renderHelpView renders the help view
+func (m Model) renderHelpView() string {
+	var sections []string
+	
+	// Header
+	sections = append(sections, m.styles.Header.Render("Help"))
+
+	// Full help
+	sections = append(sections, m.styles.Help.Render(m.help.FullHelpView(m.keys.FullHelp())))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// renderTracerouteView renders the traceroute runs to the current target
+func (m Model) renderTracerouteView() string {
+	var sections []string
+	nodeDB := m.client.GetNodeDB()
+
+	// Header
+	sections = append(sections, m.styles.Header.Render(
+		fmt.Sprintf("Traceroute to %s", nodeDB.GetNodeName(m.traceTarget)),
+	))
+
+	if m.traceRunning {
+		sections = append(sections, m.styles.Stats.Render("⏳ Waiting for route reply..."))
+	}
+
+	for _, run := range m.traceHistory {
+		if run.Err != nil {
+			sections = append(sections, m.styles.Details.Render(
+				fmt.Sprintf("%s: %v", run.Time.Format("15:04:05"), run.Err),
+			))
+			continue
+		}
+		sections = append(sections, m.styles.Details.Render(run.Result.Format(nodeDB)))
+	}
+
+	// Help
+	sections = append(sections, m.styles.Help.Render("t: run again • tab: back to packets • q: quit"))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// Helper methods
+
+func (m *Model) nextView() {
+	// Views outside the tab cycle return to the packet list
+	if m.currentView >= ViewHelp {
+		m.currentView = ViewPackets
+		return
+	}
+	m.currentView++
+}
+
+// startTraceroute traces the route to the selected packet's node, or reruns
+// the last trace from the traceroute view
+func (m *Model) startTraceroute() tea.Cmd {
+	if m.traceRunning {
+		return nil
+	}
+
+	target := m.traceTarget
+	if m.currentView != ViewTraceroute {
+		packet := m.selectedPacket()
+		if packet == nil {
+			return nil
+		}
+		target = packet.From
+		if packet.Sent {
+			target = packet.To
+		}
+		if target != m.traceTarget {
+			m.traceHistory = nil
+		}
+	}
+	if target == 0 || target == meshtastic.BroadcastAddr {
+		return nil
+	}
+
+	m.traceTarget = target
+	m.traceRunning = true
+	m.currentView = ViewTraceroute
+	return tracerouteCmd(m.client, target)
+}
+
+// toggleExport stops the pcapng export, or starts one to a new timestamped file
+func (m *Model) toggleExport() {
+	if _, _, active := m.exporter.Status(); active {
+		m.exportErr = m.exporter.Stop()
+		return
+	}
+	path := fmt.Sprintf("mesh-%s.pcapng", time.Now().Format("20060102-150405"))
+	m.exportErr = m.exporter.Start(path)
+}
+
+func (m *Model) updateTableSize() {
+	m.packetTable.SetWidth(m.width - 4)
+	m.packetTable.SetHeight(m.height - 10)
+	m.nodeTable.SetWidth(m.width - 4)
+	m.nodeTable.SetHeight(m.height - 12)
+}
+
+func (m *Model) updateStats() {
+	m.stats = m.client.GetStatistics()
+}
+
+func (m *Model) clearPackets() {
+	m.packets = make([]*meshtastic.Packet, 0)
+	m.updatePacketTable()
+}
+
+func (m *Model) addPacket(packet *meshtastic.Packet) {
+	// Add packet to the beginning of the list
+	m.packets = append([]*meshtastic.Packet{packet}, m.packets...)
+	
+	// Limit to last 1000 packets
+	if len(m.packets) > 1000 {
+		m.packets = m.packets[:1000]
+	}
+	
+	m.updatePacketTable()
+}
+
+// selectedPacket returns the packet selected in the packet list, or in the
+// details view the one opened from the device log
+func (m *Model) selectedPacket() *meshtastic.Packet {
+	if m.currentView == ViewDetails && m.linkedPacket != nil {
+		return m.linkedPacket
+	}
+	if m.selectedRow < 0 || m.selectedRow >= len(m.visible) {
+		return nil
+	}
+	return m.visible[m.selectedRow]
+}
+
+// packetVisible applies the active filter to a packet
+func (m *Model) packetVisible(packet *meshtastic.Packet) bool {
+	if !m.filterActive {
+		return true
+	}
+	if m.filterByType != 0 && packet.Type != m.filterByType {
+		return false
+	}
+	if m.filterByNode != 0 && packet.From != m.filterByNode && packet.To != m.filterByNode {
+		return false
+	}
+	return true
+}
+
+func (m *Model) updatePacketTable() {
+	var rows []table.Row
+	
+	m.visible = make([]*meshtastic.Packet, 0, len(m.packets))
+	for _, packet := range m.packets {
+		if !m.packetVisible(packet) {
+			continue
+		}
+		m.visible = append(m.visible, packet)
+
+		data := ""
+		if packet.DecodedData != nil {
+			switch d := packet.DecodedData.(type) {
+			case *meshtastic.TextData:
+				// Show enhanced information for categorized text
+				if d.Category != "general" && d.Category != "" {
+					// For device startup info, show category and key details
+					data = strings.ToUpper(d.Category)
+					if len(d.Details) > 0 {
+						// Show the most relevant detail
+						for key, value := range d.Details {
+							if key == "owner" || key == "user" || key == "name" ||
+							   key == "firmware" || key == "version" || key == "channel" {
+								data += fmt.Sprintf(": %s", value)
+								break
+							}
+						}
+					}
+				} else {
+					data = d.Text
+				}
+			case *meshtastic.PositionData:
+				lat := meshtastic.GetLatitudeDegrees(d)
+				lon := meshtastic.GetLongitudeDegrees(d)
+				if lat == 0 && lon == 0 {
+					// Check if coordinates are actually missing (nil pointers) or zero
+					if d.LatitudeI == nil && d.LongitudeI == nil {
+						data = "GPS: No fix (no data)"
+					} else {
+						data = "GPS: No fix (0,0)"
+					}
+				} else {
+					data = fmt.Sprintf("Lat:%.4f Lon:%.4f", lat, lon)
+				}
+			case *meshtastic.TelemetryData:
+				if dm := d.GetDeviceMetrics(); dm != nil {
+					data = fmt.Sprintf("Batt:%d%% V:%.2f Ch:%.1f%% Up:%ds", 
+						dm.GetBatteryLevel(), dm.GetVoltage(),
+						dm.GetChannelUtilization(), dm.GetUptimeSeconds())
+				} else if em := d.GetEnvironmentMetrics(); em != nil {
+					data = fmt.Sprintf("Temp:%.1f°C Hum:%.1f%% Press:%.1fhPa",
+						em.GetTemperature(), em.GetRelativeHumidity(),
+						em.GetBarometricPressure())
+				} else {
+					data = "Telemetry"
+				}
+			case *meshtastic.RemoteHardwareMessage:
+				data = fmt.Sprintf("%s: %s", d.Type.GetTypeName(), d.FormatGpioInfo())
+			case *meshtastic.NodeInfo:
+				if d.LongName != "" {
+					// Sanitize usernames to prevent terminal display issues
+					sanitizedLongName := utils.SanitizeForTerminal(d.LongName)
+					sanitizedShortName := utils.SanitizeForTerminal(d.ShortName)
+					data = fmt.Sprintf("%s (%s)", sanitizedLongName, sanitizedShortName)
+				} else if d.ShortName != "" {
+					data = utils.SanitizeForTerminal(d.ShortName)
+				} else if d.ID != "" {
+					data = d.ID
+				} else {
+					data = "Node Info"
+				}
+			case *meshtastic.UserData:
+				data = fmt.Sprintf("%s (%s)", utils.SanitizeForTerminal(d.LongName), utils.SanitizeForTerminal(d.ShortName))
+			case *meshtastic.MyNodeInfo:
+				data = fmt.Sprintf("My node !%08x, reboots:%d", d.GetMyNodeNum(), d.GetRebootCount())
+			case *meshtastic.Config:
+				data = fmt.Sprintf("Config: %s", meshtastic.GetPayloadVariantName(d))
+			case *meshtastic.ModuleConfig:
+				data = fmt.Sprintf("Module: %s", meshtastic.GetPayloadVariantName(d))
+			case *meshtastic.Channel:
+				data = fmt.Sprintf("Ch %d %s %s", d.GetIndex(), d.GetRole(), d.GetSettings().GetName())
+			case *meshtastic.LogRecord:
+				data = fmt.Sprintf("[%s] %s", d.GetLevel(), d.GetMessage())
+			case *meshtastic.ConfigCompleteData:
+				data = fmt.Sprintf("Config complete (id %d)", d.ID)
+			case *meshtastic.RebootedData:
+				data = "Device rebooted"
+			case *meshtastic.QueueStatus:
+				data = fmt.Sprintf("Queue: %d/%d free", d.GetFree(), d.GetMaxlen())
+			case *meshtastic.DeviceMetadata:
+				data = fmt.Sprintf("FW %s %s", d.GetFirmwareVersion(), d.GetHwModel())
+			case *meshtastic.ClientNotification:
+				data = fmt.Sprintf("[%s] %s", d.GetLevel(), d.GetMessage())
+			case *meshtastic.FileInfo:
+				data = fmt.Sprintf("%s (%d bytes)", d.GetFileName(), d.GetSizeBytes())
+			case *meshtastic.Routing:
+				data = formatRouting(packet, d)
+			case *meshtastic.AdminMessage:
+				data = fmt.Sprintf("Admin: %s", meshtastic.GetPayloadVariantName(d))
+			case *meshtastic.RouteInfo:
+				data = fmt.Sprintf("Route: %d relays out, %d back", len(d.Route), len(d.RouteBack))
+			case *meshtastic.NeighborInfo:
+				data = fmt.Sprintf("Neighbors: %d, every %ds", len(d.GetNeighbors()), d.GetNodeBroadcastIntervalSecs())
+			case *meshtastic.Waypoint:
+				data = formatWaypoint(packet, d)
+			}
+		} else {
+			// For unknown packets, show first few bytes of payload as hex
+			if len(packet.Payload) > 0 {
+				hexStr := ""
+				maxBytes := len(packet.Payload)
+				if maxBytes > 8 { // Limit to first 8 bytes for display
+					maxBytes = 8
+				}
+				for i := 0; i < maxBytes; i++ {
+					hexStr += fmt.Sprintf("%02x", packet.Payload[i])
+				}
+				if len(packet.Payload) > 8 {
+					hexStr += "..."
+				}
+				label := "Raw"
+				if packet.Encrypted {
+					label = "Encrypted"
+				}
+				data = fmt.Sprintf("%s: %s (%d bytes)", label, hexStr, len(packet.Payload))
+			} else {
+				data = "Empty payload"
+			}
+		}
+		
+		if len(data) > 25 {
+			data = data[:25] + "..."
+		}
+
+		// Format display based on packet type - use friendly names from NodeDB
+		nodeDB := m.client.GetNodeDB()
+		
+		fromDisplay := packet.GetFromShortName(nodeDB)
+		toDisplay := packet.GetToShortName(nodeDB)
+		
+		// Sanitize and truncate long names to fit in column width
+		fromDisplay = utils.TruncateForDisplay(fromDisplay, 9)
+		toDisplay = utils.TruncateForDisplay(toDisplay, 9)
+		
+		hopDisplay := packet.GetHopInfo()
+		channelDisplay := fmt.Sprintf("%d", packet.Channel)
+		if ref, ok := m.client.ResolveChannel(packet); ok {
+			channelDisplay = utils.TruncateForDisplay(ref.ShortName(), 9)
+		}
+		rssiDisplay := fmt.Sprintf("%.0f", float64(packet.RxRSSI))
+		
+		// Special formatting for device/CLI messages
+		if packet.Sent {
+			rssiDisplay = "-"
+		} else if packet.From == 0 && packet.To == 0xFFFFFFFF && packet.RxRSSI == 0 {
+			fromDisplay = "DEVICE"
+			toDisplay = "CLI"
+			hopDisplay = "-"
+			rssiDisplay = "-"
+		}
+		
+		row := table.Row{
+			packet.RxTime.Format("15:04:05"),
+			fromDisplay,
+			toDisplay,
+			packet.GetTypeName(),
+			channelDisplay,
+			hopDisplay,
+			rssiDisplay,
+			m.ackStatus(packet),
+			data,
+		}
+		rows = append(rows, row)
+	}
+	
+	m.packetTable.SetRows(rows)
+}
+
+// formatChannel describes the channel of a packet for the details view
+func (m Model) formatChannel(packet *meshtastic.Packet) string {
+	ref, ok := m.client.ResolveChannel(packet)
+	if !ok {
+		return fmt.Sprintf("%d", packet.Channel)
+	}
+	switch {
+	case ref.PKI || ref.Name == "":
+		return ref.String()
+	case ref.FromHash:
+		return fmt.Sprintf("%s, hash 0x%02X", ref, ref.Hash)
+	default:
+		return fmt.Sprintf("%s, index %d", ref, ref.Index)
+	}
+}
+
+// ackStatus returns the delivery state shown for packets we sent
+func (m *Model) ackStatus(packet *meshtastic.Packet) string {
+	if !packet.Sent {
+		return ""
+	}
+	delivery, tracked := m.client.GetDelivery(packet.ID)
+	if !tracked {
+		return "SENT"
+	}
+	switch delivery.State {
+	case meshtastic.DeliveryFailed:
+		return delivery.Reason.String()
+	case meshtastic.DeliveryImplicitAck:
+		return "IMPLICIT"
+	default:
+		return meshtastic.DeliveryStateNames[delivery.State]
+	}
+}
+
+// formatEncryption describes how a packet's payload was decrypted
+func formatEncryption(packet *meshtastic.Packet) string {
+	switch {
+	case packet.PKIEncrypted && packet.DecryptedWith != "":
+		return "PKI, decrypted locally with our private key"
+	case packet.DecryptedWith != "":
+		return fmt.Sprintf("decrypted locally with the %s channel key", packet.DecryptedWith)
+	case packet.Encrypted:
+		return "encrypted, no matching key in the keyring"
+	case packet.PKIEncrypted:
+		return "PKI, decrypted by device"
+	case packet.FromMesh:
+		return "decrypted by device"
+	default:
+		return "-"
+	}
+}
+
+// formatRouting describes a ROUTING_APP message for the Data column
+func formatRouting(packet *meshtastic.Packet, routing *meshtastic.Routing) string {
+	switch {
+	case routing.GetRouteRequest() != nil:
+		return "Route request"
+	case routing.GetRouteReply() != nil:
+		return "Route reply"
+	case routing.GetErrorReason() != 0:
+		return fmt.Sprintf("NAK %s for %08x", routing.GetErrorReason(), packet.RequestID)
+	default:
+		return fmt.Sprintf("ACK for %08x", packet.RequestID)
+	}
+}
+
+func (m *Model) onPacketReceived(packet *meshtastic.Packet) {
+	// Device log lines are shown in the device log view, not the packet list
+	if packet.Type == meshtastic.PacketTypeLogRecord {
+		return
+	}
+	
+	// This will be called from a goroutine, so we need to send a message
+	// to the main update loop via the packet channel
+	select {
+	case m.packetChan <- packet:
+		// Successfully queued for UI processing
+	default:
+		// Channel is full, drop packet to avoid blocking
+		m.logger.Println("Packet channel full, dropping packet for UI")
+	}
+}
+
+func (m *Model) onDeliveryUpdated(delivery meshtastic.Delivery) {
+	select {
+	case m.deliveryChan <- delivery:
+	default:
+		m.logger.Println("Delivery channel full, dropping update for UI")
+	}
+}
+
+// Messages
+
+type tickMsg struct{}
+
+// tracerouteMsg carries the outcome of one traceroute run
+type tracerouteMsg struct {
+	Time   time.Time
+	Result *meshtastic.TracerouteResult
+	Err    error
+}
+
+// tracerouteCmd runs a traceroute in the background, as it blocks until the reply or timeout
+func tracerouteCmd(client *meshtastic.Client, dest uint32) tea.Cmd {
+	return func() tea.Msg {
+		result, err := client.Traceroute(dest, meshtastic.DefaultHopLimit, meshtastic.DefaultTracerouteTimeout)
+		return tracerouteMsg{Time: time.Now(), Result: result, Err: err}
+	}
+}
+
+// deliveryMsg signals that a sent packet's delivery state changed
+type deliveryMsg struct {
+	Delivery meshtastic.Delivery
+}
+
+// packetMsg wraps a Meshtastic packet for Bubble Tea's update loop
+type packetMsg struct {
+	Packet *meshtastic.Packet
+}
+
+// listenForPacketsCmd listens on a channel and emits packetMsg into Bubble Tea's loop
+func listenForPacketsCmd(ch <-chan *meshtastic.Packet) tea.Cmd {
+	return func() tea.Msg {
+		p, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return packetMsg{Packet: p}
+	}
+}
+
+// listenForDeliveriesCmd emits deliveryMsg for each delivery state change
+func listenForDeliveriesCmd(ch <-chan meshtastic.Delivery) tea.Cmd {
+	return func() tea.Msg {
+		d, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return deliveryMsg{Delivery: d}
+	}
+}
+
+func tickCmd() tea.Cmd {
+	return tea.Tick(time.Second*1, func(t time.Time) tea.Msg {
+		return tickMsg{}
+	})
+}
+
+// formatLinkStatus renders the last connection event for the header
+func formatLinkStatus(event meshtastic.ConnectionEvent, reconnects int) string {
+	switch event.State {
+	case meshtastic.LinkLost, meshtastic.LinkRetrying:
+		retry := "now"
+		if wait := time.Until(event.Time.Add(event.Delay)); wait > 0 {
+			retry = "in " + wait.Round(time.Second).String()
+		}
+		return fmt.Sprintf("⚠ Connection lost: %v - reconnecting %s (%d attempts so far)", event.Err, retry, event.Attempt)
+	default:
+		return fmt.Sprintf("Reconnected at %s after %d attempts (%d reconnects this session)",
+			event.Time.Format("15:04:05"), event.Attempt, reconnects)
+	}
+}
+
+// formatConfigProgress renders the want_config handshake state for the footer
+func formatConfigProgress(progress meshtastic.ConfigProgress) string {
+	switch progress.State {
+	case meshtastic.StateConnecting:
+		return "Connecting"
+	case meshtastic.StateReceivingConfig:
+		return fmt.Sprintf("Receiving config: %d nodes, %d channels (%v)",
+			progress.Nodes, progress.Channels, time.Since(progress.Since).Truncate(time.Second))
+	default:
+		if progress.ConfigID == 0 {
+			// No handshake on this connection
+			return ""
+		}
+		return fmt.Sprintf("Ready: %d nodes, %d channels", progress.Nodes, progress.Channels)
+	}
+}
+
+// formatReplayProgress renders the replay position for the header
+func formatReplayProgress(progress meshtastic.ReplayProgress) string {
+	state := "▶ Replaying"
+	if progress.Finished {
+		state = "■ Replay finished"
+	} else if progress.Paused {
+		state = "⏸ Replay paused"
+	}
+
+	speed := "max speed"
+	if progress.Speed > 0 {
+		speed = fmt.Sprintf("%gx", progress.Speed)
+	}
+
+	return fmt.Sprintf("%s: %d/%d records, %s, at %s (space: pause/resume, .: step)",
+		state, progress.Delivered, progress.Total, speed, progress.Time.Format("2006-01-02 15:04:05"))
+}
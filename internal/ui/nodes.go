@@ -0,0 +1,285 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"go-mesh/internal/meshtastic"
+	"go-mesh/internal/utils"
+)
+
+// nodeSortMode selects the column the nodes view is sorted by
+type nodeSortMode int
+
+const (
+	sortByLastHeard nodeSortMode = iota
+	sortByName
+	sortByHops
+	sortBySNR
+	sortByDistance
+	nodeSortModeCount
+)
+
+var nodeSortNames = map[nodeSortMode]string{
+	sortByLastHeard: "last heard",
+	sortByName:      "name",
+	sortByHops:      "hops",
+	sortBySNR:       "SNR",
+	sortByDistance:  "distance",
+}
+
+// nodeRow is a node with the values the table shows and sorts by
+type nodeRow struct {
+	node     *meshtastic.NodeRecord
+	name     string
+	distance float64 // meters, negative if unknown
+}
+
+// newNodeTable creates the table used by the nodes view
+func newNodeTable() table.Model {
+	columns := []table.Column{
+		{Title: "Name", Width: 20},
+		{Title: "ID", Width: 10},
+		{Title: "Hardware", Width: 14},
+		{Title: "Role", Width: 12},
+		{Title: "Heard", Width: 9},
+		{Title: "Hops", Width: 4},
+		{Title: "SNR", Width: 6},
+		{Title: "RSSI", Width: 5},
+		{Title: "Batt", Width: 5},
+		{Title: "Dist", Width: 8},
+	}
+
+	return table.New(
+		table.WithColumns(columns),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+}
+
+// renderNodesView renders the list of nodes seen on the mesh
+func (m Model) renderNodesView() string {
+	var sections []string
+
+	// Header
+	sections = append(sections, m.styles.Header.Render(
+		fmt.Sprintf("Nodes (%d) - sorted by %s", len(m.nodeRows), nodeSortNames[m.nodeSort]),
+	))
+
+	// Filter status
+	if m.nodeFilterEditing {
+		sections = append(sections, m.styles.Filter.Render(fmt.Sprintf("Filter: %s█", m.nodeFilter)))
+	} else if m.nodeFilter != "" {
+		sections = append(sections, m.styles.Filter.Render(fmt.Sprintf("Filter: %s (/ to change)", m.nodeFilter)))
+	}
+
+	// Node table
+	sections = append(sections, m.styles.Table.Render(m.nodeTable.View()))
+
+	// Help
+	sections = append(sections, m.styles.Help.Render("enter: show node's packets • s: sort • /: filter • *: from saved NodeDB • tab: switch view • q: quit"))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// updateNodeTable rebuilds the node rows from the NodeDB
+func (m *Model) updateNodeTable() {
+	nodeDB := m.client.GetNodeDB()
+	myNodeNum := m.client.GetMyNodeNum()
+	now := m.client.Now()
+
+	var myPosition *meshtastic.Position
+	if me, exists := nodeDB.GetNode(myNodeNum); exists && me.HasPosition() {
+		myPosition = me.Position
+	}
+
+	filter := strings.ToLower(m.nodeFilter)
+	var nodes []nodeRow
+	for num, node := range nodeDB.GetAllNodes() {
+		row := nodeRow{node: node, name: nodeDB.GetNodeName(num), distance: -1}
+		if filter != "" && !nodeMatchesFilter(row, filter) {
+			continue
+		}
+		if myPosition != nil && num != myNodeNum && node.HasPosition() {
+			row.distance = meshtastic.DistanceMeters(myPosition, node.Position)
+		}
+		nodes = append(nodes, row)
+	}
+	sortNodeRows(nodes, m.nodeSort)
+
+	rows := make([]table.Row, 0, len(nodes))
+	m.nodeRows = make([]uint32, 0, len(nodes))
+	for _, row := range nodes {
+		node := row.node
+		m.nodeRows = append(m.nodeRows, node.Num)
+
+		hops, snr, rssi, battery, distance := "-", "-", "-", "-", "-"
+		if node.HopsAway != nil {
+			hops = fmt.Sprintf("%d", *node.HopsAway)
+		}
+		if hasSignal(node) {
+			snr = fmt.Sprintf("%.1f", node.LastSNR)
+			rssi = fmt.Sprintf("%d", node.LastRSSI)
+		}
+		if node.DeviceMetrics != nil && node.DeviceMetrics.BatteryLevel != nil {
+			battery = formatBattery(node.DeviceMetrics.GetBatteryLevel())
+		}
+		if row.distance >= 0 {
+			distance = formatDistance(row.distance)
+		}
+		heard := formatLastHeard(node.LastHeard, now)
+		if node.Restored {
+			// Known from the saved NodeDB but not heard this session
+			heard += "*"
+		}
+
+		rows = append(rows, table.Row{
+			utils.TruncateForDisplay(row.name, 19),
+			node.ID,
+			node.GetHardwareModelName(),
+			node.Role.String(),
+			heard,
+			hops,
+			snr,
+			rssi,
+			battery,
+			distance,
+		})
+	}
+
+	m.nodeTable.SetRows(rows)
+}
+
+// editNodeFilter handles a key press while the node filter is being typed
+func (m *Model) editNodeFilter(msg tea.KeyMsg) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.nodeFilterEditing = false
+	case tea.KeyEsc:
+		m.nodeFilterEditing = false
+		m.nodeFilter = ""
+	case tea.KeyBackspace:
+		if len(m.nodeFilter) > 0 {
+			runes := []rune(m.nodeFilter)
+			m.nodeFilter = string(runes[:len(runes)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.nodeFilter += string(msg.Runes)
+	}
+	m.updateNodeTable()
+}
+
+// showSelectedNodePackets switches to the packet list filtered to the selected node
+func (m *Model) showSelectedNodePackets() {
+	cursor := m.nodeTable.Cursor()
+	if cursor < 0 || cursor >= len(m.nodeRows) {
+		return
+	}
+
+	m.filterByNode = m.nodeRows[cursor]
+	m.filterActive = true
+	m.currentView = ViewPackets
+	m.updatePacketTable()
+	m.packetTable.SetCursor(0)
+	m.selectedRow = 0
+}
+
+// nodeMatchesFilter checks the filter text against the node's names, ID, hardware and role
+func nodeMatchesFilter(row nodeRow, filter string) bool {
+	fields := []string{
+		row.name,
+		row.node.ShortName,
+		row.node.ID,
+		row.node.GetHardwareModelName(),
+		row.node.Role.String(),
+	}
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortNodeRows sorts rows by the given mode, unknown values last
+func sortNodeRows(rows []nodeRow, mode nodeSortMode) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := rows[i], rows[j]
+		switch mode {
+		case sortByName:
+			return strings.ToLower(a.name) < strings.ToLower(b.name)
+		case sortByHops:
+			if (a.node.HopsAway == nil) != (b.node.HopsAway == nil) {
+				return a.node.HopsAway != nil
+			}
+			if a.node.HopsAway != nil && *a.node.HopsAway != *b.node.HopsAway {
+				return *a.node.HopsAway < *b.node.HopsAway
+			}
+		case sortBySNR:
+			if aKnown, bKnown := hasSignal(a.node), hasSignal(b.node); aKnown != bKnown {
+				return aKnown
+			}
+			if a.node.LastSNR != b.node.LastSNR {
+				return a.node.LastSNR > b.node.LastSNR
+			}
+		case sortByDistance:
+			if (a.distance < 0) != (b.distance < 0) {
+				return a.distance >= 0
+			}
+			if a.distance != b.distance {
+				return a.distance < b.distance
+			}
+		}
+		// Most recently heard first, also the tie-breaker for other modes
+		if !a.node.LastHeard.Equal(b.node.LastHeard) {
+			return a.node.LastHeard.After(b.node.LastHeard)
+		}
+		return a.node.Num < b.node.Num
+	})
+}
+
+// hasSignal reports whether a signal reading was received from the node
+func hasSignal(node *meshtastic.NodeRecord) bool {
+	return node.LastSNR != 0 || node.LastRSSI != 0
+}
+
+// formatLastHeard renders how long before now a node was heard
+func formatLastHeard(lastHeard, now time.Time) string {
+	if lastHeard.IsZero() {
+		return "never"
+	}
+	age := now.Sub(lastHeard)
+	switch {
+	case age < 0:
+		return "0s ago"
+	case age < time.Minute:
+		return fmt.Sprintf("%ds ago", int(age.Seconds()))
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age.Minutes()))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(age.Hours()/24))
+	}
+}
+
+// formatBattery renders a battery level, where 101 means externally powered
+func formatBattery(level uint32) string {
+	if level > 100 {
+		return "PWR"
+	}
+	return fmt.Sprintf("%d%%", level)
+}
+
+// formatDistance renders a distance in meters or kilometers
+func formatDistance(meters float64) string {
+	if meters < 1000 {
+		return fmt.Sprintf("%.0fm", meters)
+	}
+	return fmt.Sprintf("%.1fkm", meters/1000)
+}
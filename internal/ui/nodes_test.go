@@ -0,0 +1,24 @@
+package ui
+
+import (
+	"testing"
+
+	"go-mesh/internal/meshtastic"
+)
+
+// Test that sorting by SNR puts the strongest signal first and nodes without
+// a reading last
+func TestSortNodeRowsBySNR(t *testing.T) {
+	rows := []nodeRow{
+		{node: &meshtastic.NodeRecord{Num: 1}, name: "unknown"},
+		{node: &meshtastic.NodeRecord{Num: 2, LastSNR: -5, LastRSSI: -110}, name: "weak"},
+		{node: &meshtastic.NodeRecord{Num: 3, LastSNR: 3, LastRSSI: -90}, name: "strong"},
+	}
+	sortNodeRows(rows, sortBySNR)
+
+	for i, expected := range []string{"strong", "weak", "unknown"} {
+		if rows[i].name != expected {
+			t.Errorf("Expected %s at %d, got %s", expected, i, rows[i].name)
+		}
+	}
+}
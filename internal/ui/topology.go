@@ -0,0 +1,186 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"go-mesh/internal/meshtastic"
+)
+
+// topologyEdge is the pair of directed links between two nodes, nil where
+// that direction wasn't observed
+type topologyEdge struct {
+	in  *meshtastic.TopologyLink // the parent heard the child
+	out *meshtastic.TopologyLink // the child heard the parent
+}
+
+// renderTopologyView renders who can hear whom as trees of shortest paths
+// from our node, followed by every observed link
+func (m Model) renderTopologyView() string {
+	var sections []string
+	topology := m.client.GetTopology()
+	links := topology.Links()
+
+	// Header
+	sections = append(sections, m.styles.Header.Render(
+		fmt.Sprintf("Mesh Topology (%d nodes, %d links)", len(topology.Nodes()), len(links)),
+	))
+
+	if m.topologyStatus != "" {
+		sections = append(sections, m.styles.Filter.Render(m.topologyStatus))
+	}
+
+	lines := m.topologyLines(links)
+	if len(lines) == 0 {
+		sections = append(sections, m.styles.Details.Render(
+			"No links seen yet. They come from NeighborInfo packets, traceroute replies\nand packets our node hears without relays."))
+	} else {
+		// Scroll the lines to fit the screen
+		height := max(m.height-10, 5)
+		offset := min(m.topologyOffset, max(len(lines)-height, 0))
+		end := min(offset+height, len(lines))
+		sections = append(sections, m.styles.Stats.Render(strings.Join(lines[offset:end], "\n")))
+	}
+
+	// Help
+	sections = append(sections, m.styles.Help.Render("↑/↓: scroll • in/out: SNR heard by parent/child • w: export DOT and GraphML • tab: switch view • q: quit"))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// topologyLines formats the topology trees and the link list
+func (m Model) topologyLines(links []meshtastic.TopologyLink) []string {
+	if len(links) == 0 {
+		return nil
+	}
+	nodeDB := m.client.GetNodeDB()
+
+	// Undirected adjacency keeping both directions of each edge
+	adjacency := make(map[uint32]map[uint32]*topologyEdge)
+	edge := func(a, b uint32) *topologyEdge {
+		if adjacency[a] == nil {
+			adjacency[a] = make(map[uint32]*topologyEdge)
+		}
+		if adjacency[a][b] == nil {
+			adjacency[a][b] = &topologyEdge{}
+		}
+		return adjacency[a][b]
+	}
+	for i := range links {
+		link := &links[i]
+		edge(link.To, link.From).in = link
+		edge(link.From, link.To).out = link
+	}
+
+	// Start from our node, then any part of the mesh not connected to it
+	roots := m.client.GetTopology().Nodes()
+	me := m.client.GetMyNodeNum()
+	if _, ok := adjacency[me]; ok {
+		roots = append([]uint32{me}, roots...)
+	}
+
+	var lines []string
+	visited := make(map[uint32]bool)
+	for _, root := range roots {
+		if visited[root] {
+			continue
+		}
+		children := topologyTree(root, adjacency, visited, nodeDB)
+
+		title := fmt.Sprintf("%s (!%08x)", nodeDB.GetNodeName(root), root)
+		if root == me {
+			title += " - us"
+		}
+		lines = append(lines, m.styles.Section.Render(title))
+		lines = appendTopologyTree(lines, root, "", children, adjacency, nodeDB)
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, m.styles.Section.Render("Links (receiver heard sender)"))
+	now := m.client.Now()
+	for _, link := range links {
+		snr := "?"
+		if link.SNRKnown {
+			snr = fmt.Sprintf("%.2f dB", link.SNR)
+		}
+		lines = append(lines, fmt.Sprintf("  %-20s → %-20s %9s  %-12s %s ago",
+			nodeDB.GetNodeName(link.From), nodeDB.GetNodeName(link.To), snr, link.Source,
+			now.Sub(link.LastSeen).Truncate(time.Second)))
+	}
+	return lines
+}
+
+// topologyTree finds the shortest paths from root to every node it is
+// connected to and returns each node's children, ordered by name
+func topologyTree(root uint32, adjacency map[uint32]map[uint32]*topologyEdge, visited map[uint32]bool, nodeDB *meshtastic.NodeDB) map[uint32][]uint32 {
+	children := make(map[uint32][]uint32)
+	visited[root] = true
+	queue := []uint32{root}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		var next []uint32
+		for neighbor := range adjacency[node] {
+			if !visited[neighbor] {
+				visited[neighbor] = true
+				next = append(next, neighbor)
+			}
+		}
+		sort.Slice(next, func(i, j int) bool {
+			return nodeDB.GetNodeName(next[i]) < nodeDB.GetNodeName(next[j])
+		})
+		children[node] = next
+		queue = append(queue, next...)
+	}
+	return children
+}
+
+// appendTopologyTree draws node's subtree with box-drawing branches
+func appendTopologyTree(lines []string, node uint32, prefix string, children map[uint32][]uint32, adjacency map[uint32]map[uint32]*topologyEdge, nodeDB *meshtastic.NodeDB) []string {
+	for i, child := range children[node] {
+		branch, indent := "├── ", "│   "
+		if i == len(children[node])-1 {
+			branch, indent = "└── ", "    "
+		}
+		edge := adjacency[node][child]
+		lines = append(lines, fmt.Sprintf("%s%s%s  in %s  out %s",
+			prefix, branch, nodeDB.GetNodeName(child), formatLinkSNR(edge.in), formatLinkSNR(edge.out)))
+		lines = appendTopologyTree(lines, child, prefix+indent, children, adjacency, nodeDB)
+	}
+	return lines
+}
+
+// formatLinkSNR formats one direction of an edge, - if it wasn't observed
+func formatLinkSNR(link *meshtastic.TopologyLink) string {
+	switch {
+	case link == nil:
+		return "-"
+	case !link.SNRKnown:
+		return "?"
+	default:
+		return fmt.Sprintf("%.2fdB", link.SNR)
+	}
+}
+
+// scrollTopology moves the topology view by delta lines
+func (m *Model) scrollTopology(delta int) {
+	lines := len(m.topologyLines(m.client.GetTopology().Links()))
+	m.topologyOffset = max(min(m.topologyOffset+delta, lines-1), 0)
+}
+
+// exportTopology writes the topology to timestamped DOT and GraphML files
+func (m *Model) exportTopology() {
+	base := fmt.Sprintf("mesh-topology-%s", time.Now().Format("20060102-150405"))
+	nodeDB := m.client.GetNodeDB()
+	for _, ext := range []string{".dot", ".graphml"} {
+		if err := m.client.GetTopology().Save(base+ext, nodeDB); err != nil {
+			m.topologyStatus = fmt.Sprintf("Export failed: %v", err)
+			return
+		}
+	}
+	m.topologyStatus = fmt.Sprintf("Exported to %s.dot and %s.graphml", base, base)
+}
@@ -0,0 +1,107 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"go-mesh/internal/meshtastic"
+	"go-mesh/internal/utils"
+)
+
+// renderWaypointsView lists the waypoints seen on the mesh with their
+// distance from our node
+func (m Model) renderWaypointsView() string {
+	var sections []string
+	now := m.client.Now()
+	waypoints := m.client.GetWaypoints().List(now)
+
+	// Header
+	sections = append(sections, m.styles.Header.Render(fmt.Sprintf("Waypoints (%d)", len(waypoints))))
+
+	lines := m.waypointLines(waypoints, now)
+	if len(lines) == 0 {
+		sections = append(sections, m.styles.Details.Render(
+			"No waypoints seen yet. Send one with \"mesh-debug waypoint send\" or from an app."))
+	} else {
+		// Scroll the lines to fit the screen
+		height := max(m.height-10, 5)
+		offset := min(m.waypointOffset, max(len(lines)-height, 0))
+		end := min(offset+height, len(lines))
+		sections = append(sections, m.styles.Stats.Render(strings.Join(lines[offset:end], "\n")))
+	}
+
+	// Help
+	sections = append(sections, m.styles.Help.Render("↑/↓: scroll • [L]: locked, only the owner can change it • tab: switch view • q: quit"))
+
+	return m.styles.App.Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
+
+// waypointLines formats each waypoint as a summary line and its description
+func (m Model) waypointLines(waypoints []meshtastic.WaypointRecord, now time.Time) []string {
+	nodeDB := m.client.GetNodeDB()
+	var myPosition *meshtastic.Position
+	if me, exists := nodeDB.GetNode(m.client.GetMyNodeNum()); exists && me.HasPosition() {
+		myPosition = me.Position
+	}
+
+	var lines []string
+	for _, record := range waypoints {
+		waypoint := record.Waypoint
+
+		position, distance := "-", "-"
+		if record.HasPosition() {
+			position = fmt.Sprintf("%.5f, %.5f",
+				meshtastic.GetLatitudeDegrees(record.Position()), meshtastic.GetLongitudeDegrees(record.Position()))
+			if myPosition != nil {
+				distance = formatDistance(meshtastic.DistanceMeters(myPosition, record.Position()))
+			}
+		}
+
+		channel := "-"
+		if record.Channel >= 0 {
+			channel = fmt.Sprint(record.Channel)
+		}
+
+		lock := ""
+		if locked := waypoint.GetLockedTo(); locked != 0 {
+			lock = "  [L] " + nodeDB.GetNodeShortName(locked)
+		}
+
+		// Emoji icons have no reliable width, so the icon goes after the columns
+		lines = append(lines, fmt.Sprintf("%-30s %-22s %8s  %-14s ch %s  from %s, %s ago  #%d%s  %s",
+			utils.SanitizeForTerminal(waypoint.GetName()), position, distance,
+			formatWaypointExpiry(waypoint, now), channel, nodeDB.GetNodeName(record.From),
+			now.Sub(record.Updated).Truncate(time.Second), waypoint.GetId(), lock,
+			utils.SanitizeForTerminal(record.Icon())))
+		if description := waypoint.GetDescription(); description != "" {
+			lines = append(lines, "   "+utils.SanitizeForTerminal(description))
+		}
+	}
+	return lines
+}
+
+// formatWaypointExpiry shows how long until a waypoint expires
+func formatWaypointExpiry(waypoint *meshtastic.Waypoint, now time.Time) string {
+	if waypoint.GetExpire() == 0 {
+		return "never expires"
+	}
+	left := time.Unix(int64(waypoint.GetExpire()), 0).Sub(now)
+	return fmt.Sprintf("expires in %s", left.Truncate(time.Minute))
+}
+
+// scrollWaypoints moves the waypoints view by delta lines
+func (m *Model) scrollWaypoints(delta int) {
+	now := m.client.Now()
+	lines := len(m.waypointLines(m.client.GetWaypoints().List(now), now))
+	m.waypointOffset = max(min(m.waypointOffset+delta, lines-1), 0)
+}
+
+// formatWaypoint describes a WAYPOINT_APP message for the Data column
+func formatWaypoint(packet *meshtastic.Packet, waypoint *meshtastic.Waypoint) string {
+	if expire := waypoint.GetExpire(); expire != 0 && int64(expire) <= packet.RxTime.Unix() {
+		return fmt.Sprintf("Delete waypoint %d", waypoint.GetId())
+	}
+	return fmt.Sprintf("Waypoint: %s", utils.SanitizeForTerminal(waypoint.GetName()))
+}